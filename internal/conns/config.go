@@ -62,6 +62,7 @@ type Config struct {
 	TokenBucketRateLimiterCapacity int
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
+	ValidatePolicies               bool
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -223,6 +224,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
+	client.validatePolicies = c.ValidatePolicies
 
 	return client, diags
 }
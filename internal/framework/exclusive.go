@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ExclusiveMembers describes the members of a parent resource that are managed exclusively,
+// such as the rules of a security group or the subscriptions of an SNS topic.
+//
+// Resources that assert exclusive ownership of a parent's members read the members that exist,
+// compare them with the configured members and, on apply, remove any member that is not configured.
+type ExclusiveMembers[K comparable] struct {
+	// Have are the keys of the members that currently exist.
+	Have []K
+	// Want are the keys of the configured members.
+	Want []K
+	// Add creates a configured member that does not exist.
+	// Optional. If nil, configured members that do not exist are reported as an error.
+	Add func(context.Context, K) error
+	// Remove deletes a member that exists but is not configured.
+	Remove func(context.Context, K) error
+}
+
+// Diff returns the keys of configured members that do not exist and of existing members that are not configured.
+func (m ExclusiveMembers[K]) Diff() (add, remove []K) {
+	for _, k := range m.Want {
+		if !slices.Contains(m.Have, k) {
+			add = append(add, k)
+		}
+	}
+
+	for _, k := range m.Have {
+		if !slices.Contains(m.Want, k) {
+			remove = append(remove, k)
+		}
+	}
+
+	return add, remove
+}
+
+// Sync brings the existing members in line with the configured members.
+// Missing members are added before extra members are removed.
+func (m ExclusiveMembers[K]) Sync(ctx context.Context) error {
+	add, remove := m.Diff()
+
+	if m.Add == nil {
+		var errs []error
+		for _, k := range add {
+			errs = append(errs, fmt.Errorf("configured member (%v) not found", k))
+		}
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+	} else {
+		for _, k := range add {
+			if err := m.Add(ctx, k); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, k := range remove {
+		if err := m.Remove(ctx, k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+func TestExclusiveMembersSync(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		have        []string
+		want        []string
+		canAdd      bool
+		wantAdded   []string
+		wantRemoved []string
+		wantErr     bool
+	}{
+		"in sync": {
+			have: []string{"a", "b"},
+			want: []string{"b", "a"},
+		},
+		"extra members": {
+			have:        []string{"a", "b", "c"},
+			want:        []string{"a"},
+			wantRemoved: []string{"b", "c"},
+		},
+		"missing members added": {
+			have:        []string{"a", "c"},
+			want:        []string{"a", "b"},
+			canAdd:      true,
+			wantAdded:   []string{"b"},
+			wantRemoved: []string{"c"},
+		},
+		"missing members not added": {
+			have:    []string{"a", "c"},
+			want:    []string{"a", "b"},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var added, removed []string
+			members := framework.ExclusiveMembers[string]{
+				Have: testCase.have,
+				Want: testCase.want,
+				Remove: func(_ context.Context, k string) error {
+					removed = append(removed, k)
+					return nil
+				},
+			}
+			if testCase.canAdd {
+				members.Add = func(_ context.Context, k string) error {
+					added = append(added, k)
+					return nil
+				}
+			}
+
+			err := members.Sync(context.Background())
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("Sync() err %t, want %t: %v", got, want, err)
+			}
+			if err != nil {
+				if removed != nil {
+					t.Errorf("removed %v before reporting missing members", removed)
+				}
+				return
+			}
+			if diff := cmp.Diff(added, testCase.wantAdded); diff != "" {
+				t.Errorf("unexpected added diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(removed, testCase.wantRemoved); diff != "" {
+				t.Errorf("unexpected removed diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -176,3 +176,15 @@ func FlattenFrameworkStringValueSetLegacy[T ~string](_ context.Context, vs []T)
 
 	return types.SetValueMust(types.StringType, elems)
 }
+
+// FlattenFrameworkInt32ValueSetLegacy is the Plugin Framework variant of FlattenInt32ValueSet.
+// A nil slice is converted to an empty (non-null) Set.
+func FlattenFrameworkInt32ValueSetLegacy[T ~int32](_ context.Context, vs []T) types.Set {
+	elems := make([]attr.Value, len(vs))
+
+	for i, v := range vs {
+		elems[i] = types.Int64Value(int64(v))
+	}
+
+	return types.SetValueMust(types.Int64Type, elems)
+}
@@ -626,3 +626,41 @@ func TestFlattenFrameworkStringValueSetLegacy(t *testing.T) {
 		})
 	}
 }
+
+func TestFlattenFrameworkInt32ValueSetLegacy(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input    []int32
+		expected types.Set
+	}
+	tests := map[string]testCase{
+		"two elements": {
+			input: []int32{1, -1},
+			expected: types.SetValueMust(types.Int64Type, []attr.Value{
+				types.Int64Value(1),
+				types.Int64Value(-1),
+			}),
+		},
+		"zero elements": {
+			input:    []int32{},
+			expected: types.SetValueMust(types.Int64Type, []attr.Value{}),
+		},
+		"nil array": {
+			input:    nil,
+			expected: types.SetValueMust(types.Int64Type, []attr.Value{}),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := flex.FlattenFrameworkInt32ValueSetLegacy(context.Background(), test.input)
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
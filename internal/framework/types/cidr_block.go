@@ -87,8 +87,9 @@ func (cidrBlockType) ValueType(context.Context) attr.Value {
 }
 
 var (
-	_ basetypes.StringValuable    = (*CIDRBlock)(nil)
-	_ xattr.ValidateableAttribute = (*CIDRBlock)(nil)
+	_ basetypes.StringValuable                   = (*CIDRBlock)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*CIDRBlock)(nil)
+	_ xattr.ValidateableAttribute                = (*CIDRBlock)(nil)
 )
 
 func CIDRBlockNull() CIDRBlock {
@@ -121,6 +122,20 @@ func (CIDRBlock) Type(context.Context) attr.Type {
 	return CIDRBlockType
 }
 
+// StringSemanticEquals returns whether the two values represent the same CIDR block
+// regardless of textual form, e.g. compressed vs. expanded or upper vs. lower case IPv6.
+func (v CIDRBlock) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(CIDRBlock)
+
+	if !ok {
+		return false, diags
+	}
+
+	return itypes.CIDRBlocksEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
 func (v CIDRBlock) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
 	if v.IsNull() || v.IsUnknown() {
 		return
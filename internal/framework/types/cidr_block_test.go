@@ -140,3 +140,53 @@ func TestCIDRBlockToStringValue(t *testing.T) {
 		})
 	}
 }
+
+func TestCIDRBlockStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.CIDRBlock
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"IPv4 CIDR blocks, equal": {
+			val1:   fwtypes.CIDRBlockValue("10.2.2.0/24"),
+			val2:   fwtypes.CIDRBlockValue("10.2.2.0/24"),
+			equals: true,
+		},
+		"IPv4 CIDR blocks, not equal": {
+			val1:   fwtypes.CIDRBlockValue("10.2.2.0/24"),
+			val2:   fwtypes.CIDRBlockValue("10.2.3.0/24"),
+			equals: false,
+		},
+		"IPv6 CIDR blocks upper and lower case, equal": {
+			val1:   fwtypes.CIDRBlockValue("2001:DB8::/32"),
+			val2:   fwtypes.CIDRBlockValue("2001:db8::/32"),
+			equals: true,
+		},
+		"IPv6 CIDR blocks compressed and expanded, equal": {
+			val1:   fwtypes.CIDRBlockValue("2001:db8::/32"),
+			val2:   fwtypes.CIDRBlockValue("2001:0db8:0000:0000:0000:0000:0000:0000/32"),
+			equals: true,
+		},
+		"IPv6 CIDR blocks, not equal": {
+			val1:   fwtypes.CIDRBlockValue("2001:db8::/32"),
+			val2:   fwtypes.CIDRBlockValue("2001:db8::/48"),
+			equals: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
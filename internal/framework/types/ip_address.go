@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var (
+	_ basetypes.StringTypable = (*ipAddressType)(nil)
+)
+
+type ipAddressType struct {
+	basetypes.StringType
+}
+
+var (
+	IPAddressType = ipAddressType{}
+)
+
+func (t ipAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(ipAddressType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (ipAddressType) String() string {
+	return "IPAddressType"
+}
+
+func (t ipAddressType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return IPAddressNull(), diags
+	}
+	if in.IsUnknown() {
+		return IPAddressUnknown(), diags
+	}
+
+	return IPAddress{StringValue: in}, diags
+}
+
+func (t ipAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (ipAddressType) ValueType(context.Context) attr.Value {
+	return IPAddress{}
+}
+
+var (
+	_ basetypes.StringValuable                   = (*IPAddress)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*IPAddress)(nil)
+	_ xattr.ValidateableAttribute                = (*IPAddress)(nil)
+)
+
+func IPAddressNull() IPAddress {
+	return IPAddress{StringValue: basetypes.NewStringNull()}
+}
+
+func IPAddressUnknown() IPAddress {
+	return IPAddress{StringValue: basetypes.NewStringUnknown()}
+}
+
+func IPAddressValue(value string) IPAddress {
+	return IPAddress{StringValue: basetypes.NewStringValue(value)}
+}
+
+type IPAddress struct {
+	basetypes.StringValue
+}
+
+func (v IPAddress) Equal(o attr.Value) bool {
+	other, ok := o.(IPAddress)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (IPAddress) Type(context.Context) attr.Type {
+	return IPAddressType
+}
+
+// StringSemanticEquals returns whether the two values represent the same IP address
+// regardless of textual form, e.g. compressed vs. expanded or upper vs. lower case IPv6.
+func (v IPAddress) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPAddress)
+
+	if !ok {
+		return false, diags
+	}
+
+	return itypes.IPAddressesEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+func (v IPAddress) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if err := itypes.ValidateIPAddress(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address Value",
+			"The provided value failed validation.\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
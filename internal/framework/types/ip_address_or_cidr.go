@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var (
+	_ basetypes.StringTypable = (*ipAddressOrCIDRType)(nil)
+)
+
+type ipAddressOrCIDRType struct {
+	basetypes.StringType
+}
+
+var (
+	IPAddressOrCIDRType = ipAddressOrCIDRType{}
+)
+
+func (t ipAddressOrCIDRType) Equal(o attr.Type) bool {
+	other, ok := o.(ipAddressOrCIDRType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (ipAddressOrCIDRType) String() string {
+	return "IPAddressOrCIDRType"
+}
+
+func (t ipAddressOrCIDRType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return IPAddressOrCIDRNull(), diags
+	}
+	if in.IsUnknown() {
+		return IPAddressOrCIDRUnknown(), diags
+	}
+
+	return IPAddressOrCIDR{StringValue: in}, diags
+}
+
+func (t ipAddressOrCIDRType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (ipAddressOrCIDRType) ValueType(context.Context) attr.Value {
+	return IPAddressOrCIDR{}
+}
+
+var (
+	_ basetypes.StringValuable                   = (*IPAddressOrCIDR)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*IPAddressOrCIDR)(nil)
+	_ xattr.ValidateableAttribute                = (*IPAddressOrCIDR)(nil)
+)
+
+func IPAddressOrCIDRNull() IPAddressOrCIDR {
+	return IPAddressOrCIDR{StringValue: basetypes.NewStringNull()}
+}
+
+func IPAddressOrCIDRUnknown() IPAddressOrCIDR {
+	return IPAddressOrCIDR{StringValue: basetypes.NewStringUnknown()}
+}
+
+func IPAddressOrCIDRValue(value string) IPAddressOrCIDR {
+	return IPAddressOrCIDR{StringValue: basetypes.NewStringValue(value)}
+}
+
+type IPAddressOrCIDR struct {
+	basetypes.StringValue
+}
+
+func (v IPAddressOrCIDR) Equal(o attr.Value) bool {
+	other, ok := o.(IPAddressOrCIDR)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (IPAddressOrCIDR) Type(context.Context) attr.Type {
+	return IPAddressOrCIDRType
+}
+
+// StringSemanticEquals returns whether the two values represent the same IP address or CIDR block
+// regardless of textual form, e.g. compressed vs. expanded or upper vs. lower case IPv6.
+func (v IPAddressOrCIDR) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPAddressOrCIDR)
+
+	if !ok {
+		return false, diags
+	}
+
+	return itypes.IPAddressOrCIDRsEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+func (v IPAddressOrCIDR) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if err := itypes.ValidateIPAddressOrCIDR(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address or CIDR Block Value",
+			"The provided value failed validation.\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
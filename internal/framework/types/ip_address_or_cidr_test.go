@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestIPAddressOrCIDRValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         fwtypes.IPAddressOrCIDR
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: fwtypes.IPAddressOrCIDRUnknown(),
+		},
+		"null": {
+			val: fwtypes.IPAddressOrCIDRNull(),
+		},
+		"valid IPv4 address": {
+			val: fwtypes.IPAddressOrCIDRValue("10.2.2.1"),
+		},
+		"valid IPv4 CIDR block": {
+			val: fwtypes.IPAddressOrCIDRValue("10.2.2.0/24"),
+		},
+		"invalid IPv4 CIDR block": {
+			val:         fwtypes.IPAddressOrCIDRValue("10.2.2.2/24"),
+			expectError: true,
+		},
+		"valid IPv6 address": {
+			val: fwtypes.IPAddressOrCIDRValue("2001:DB8::1"),
+		},
+		"valid IPv6 CIDR block": {
+			val: fwtypes.IPAddressOrCIDRValue("2001:DB8::/32"),
+		},
+		"invalid IPv6 CIDR block": {
+			val:         fwtypes.IPAddressOrCIDRValue("2001:db8::1/32"),
+			expectError: true,
+		},
+		"invalid": {
+			val:         fwtypes.IPAddressOrCIDRValue("not ok"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req := xattr.ValidateAttributeRequest{}
+			resp := xattr.ValidateAttributeResponse{}
+
+			test.val.ValidateAttribute(ctx, req, &resp)
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("resp.Diagnostics.HasError() = %t, want = %t", resp.Diagnostics.HasError(), test.expectError)
+			}
+		})
+	}
+}
+
+func TestIPAddressOrCIDRStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.IPAddressOrCIDR
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"IPv4 CIDR blocks, equal": {
+			val1:   fwtypes.IPAddressOrCIDRValue("10.2.2.0/24"),
+			val2:   fwtypes.IPAddressOrCIDRValue("10.2.2.0/24"),
+			equals: true,
+		},
+		"IPv6 CIDR blocks upper and lower case, equal": {
+			val1:   fwtypes.IPAddressOrCIDRValue("2001:DB8::/32"),
+			val2:   fwtypes.IPAddressOrCIDRValue("2001:db8::/32"),
+			equals: true,
+		},
+		"IPv6 CIDR blocks compressed and expanded, equal": {
+			val1:   fwtypes.IPAddressOrCIDRValue("2001:db8::/32"),
+			val2:   fwtypes.IPAddressOrCIDRValue("2001:0db8:0000:0000:0000:0000:0000:0000/32"),
+			equals: true,
+		},
+		"IPv6 CIDR blocks, not equal": {
+			val1:   fwtypes.IPAddressOrCIDRValue("2001:db8::/32"),
+			val2:   fwtypes.IPAddressOrCIDRValue("2001:db8::/48"),
+			equals: false,
+		},
+		"IPv6 addresses, equal": {
+			val1:   fwtypes.IPAddressOrCIDRValue("2001:DB8::1"),
+			val2:   fwtypes.IPAddressOrCIDRValue("2001:db8::1"),
+			equals: true,
+		},
+		"IP address and host CIDR block, not equal": {
+			val1:   fwtypes.IPAddressOrCIDRValue("10.2.2.1"),
+			val2:   fwtypes.IPAddressOrCIDRValue("10.2.2.1/32"),
+			equals: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
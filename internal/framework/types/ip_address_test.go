@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestIPAddressTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val      tftypes.Value
+		expected attr.Value
+	}{
+		"null value": {
+			val:      tftypes.NewValue(tftypes.String, nil),
+			expected: fwtypes.IPAddressNull(),
+		},
+		"unknown value": {
+			val:      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: fwtypes.IPAddressUnknown(),
+		},
+		"valid IP address": {
+			val:      tftypes.NewValue(tftypes.String, "2001:DB8::1"),
+			expected: fwtypes.IPAddressValue("2001:DB8::1"),
+		},
+		"invalid IP address": {
+			val:      tftypes.NewValue(tftypes.String, "not ok"),
+			expected: fwtypes.IPAddressValue("not ok"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			val, err := fwtypes.IPAddressType.ValueFromTerraform(ctx, test.val)
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(val, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPAddressValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         fwtypes.IPAddress
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: fwtypes.IPAddressUnknown(),
+		},
+		"null": {
+			val: fwtypes.IPAddressNull(),
+		},
+		"valid IPv4": {
+			val: fwtypes.IPAddressValue("10.2.2.1"),
+		},
+		"invalid IPv4": {
+			val:         fwtypes.IPAddressValue("10.2.2.256"),
+			expectError: true,
+		},
+		"valid IPv6": {
+			val: fwtypes.IPAddressValue("2001:0DB8:0000:0000:0000:0000:0000:0001"),
+		},
+		"CIDR block": {
+			val:         fwtypes.IPAddressValue("2001:db8::/32"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req := xattr.ValidateAttributeRequest{}
+			resp := xattr.ValidateAttributeResponse{}
+
+			test.val.ValidateAttribute(ctx, req, &resp)
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("resp.Diagnostics.HasError() = %t, want = %t", resp.Diagnostics.HasError(), test.expectError)
+			}
+		})
+	}
+}
+
+func TestIPAddressStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.IPAddress
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"IPv4, equal": {
+			val1:   fwtypes.IPAddressValue("10.2.2.1"),
+			val2:   fwtypes.IPAddressValue("10.2.2.1"),
+			equals: true,
+		},
+		"IPv4, not equal": {
+			val1:   fwtypes.IPAddressValue("10.2.2.1"),
+			val2:   fwtypes.IPAddressValue("10.2.2.2"),
+			equals: false,
+		},
+		"IPv6 compressed and expanded, equal": {
+			val1:   fwtypes.IPAddressValue("2001:db8::1"),
+			val2:   fwtypes.IPAddressValue("2001:0db8:0000:0000:0000:0000:0000:0001"),
+			equals: true,
+		},
+		"IPv6 upper and lower case, equal": {
+			val1:   fwtypes.IPAddressValue("2001:DB8::A"),
+			val2:   fwtypes.IPAddressValue("2001:db8::a"),
+			equals: true,
+		},
+		"IPv6, not equal": {
+			val1:   fwtypes.IPAddressValue("2001:db8::1"),
+			val2:   fwtypes.IPAddressValue("2001:db8::2"),
+			equals: false,
+		},
+		"invalid": {
+			val1:   fwtypes.IPAddressValue("not ok"),
+			val2:   fwtypes.IPAddressValue("not ok"),
+			equals: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
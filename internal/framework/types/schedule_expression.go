@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+var (
+	_ basetypes.StringTypable = (*scheduleExpressionType)(nil)
+)
+
+type scheduleExpressionType struct {
+	basetypes.StringType
+}
+
+var (
+	ScheduleExpressionType = scheduleExpressionType{}
+)
+
+func (t scheduleExpressionType) Equal(o attr.Type) bool {
+	other, ok := o.(scheduleExpressionType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (scheduleExpressionType) String() string {
+	return "ScheduleExpressionType"
+}
+
+func (t scheduleExpressionType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return ScheduleExpressionNull(), diags
+	}
+	if in.IsUnknown() {
+		return ScheduleExpressionUnknown(), diags
+	}
+
+	return ScheduleExpression{StringValue: in}, diags
+}
+
+func (t scheduleExpressionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (scheduleExpressionType) ValueType(context.Context) attr.Value {
+	return ScheduleExpression{}
+}
+
+var (
+	_ basetypes.StringValuable                   = (*ScheduleExpression)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*ScheduleExpression)(nil)
+	_ xattr.ValidateableAttribute                = (*ScheduleExpression)(nil)
+)
+
+func ScheduleExpressionNull() ScheduleExpression {
+	return ScheduleExpression{StringValue: basetypes.NewStringNull()}
+}
+
+func ScheduleExpressionUnknown() ScheduleExpression {
+	return ScheduleExpression{StringValue: basetypes.NewStringUnknown()}
+}
+
+func ScheduleExpressionValue(value string) ScheduleExpression {
+	return ScheduleExpression{StringValue: basetypes.NewStringValue(value)}
+}
+
+type ScheduleExpression struct {
+	basetypes.StringValue
+}
+
+func (v ScheduleExpression) Equal(o attr.Value) bool {
+	other, ok := o.(ScheduleExpression)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (ScheduleExpression) Type(context.Context) attr.Type {
+	return ScheduleExpressionType
+}
+
+// StringSemanticEquals returns whether the two values represent the same schedule
+// regardless of textual form, e.g. whitespace or upper vs. lower case month and day names.
+func (v ScheduleExpression) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ScheduleExpression)
+
+	if !ok {
+		return false, diags
+	}
+
+	old, err := schedule.Parse(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	new, err := schedule.Parse(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return old.String() == new.String(), diags
+}
+
+func (v ScheduleExpression) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := schedule.Parse(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Schedule Expression Value",
+			"The provided value failed validation.\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestScheduleExpressionValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         fwtypes.ScheduleExpression
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: fwtypes.ScheduleExpressionUnknown(),
+		},
+		"null": {
+			val: fwtypes.ScheduleExpressionNull(),
+		},
+		"valid cron": {
+			val: fwtypes.ScheduleExpressionValue("cron(0 12 ? * MON-FRI *)"),
+		},
+		"invalid cron": {
+			val:         fwtypes.ScheduleExpressionValue("cron(0 12 * * *)"),
+			expectError: true,
+		},
+		"valid rate": {
+			val: fwtypes.ScheduleExpressionValue("rate(5 minutes)"),
+		},
+		"valid at": {
+			val: fwtypes.ScheduleExpressionValue("at(2025-10-01T12:30:00)"),
+		},
+		"invalid": {
+			val:         fwtypes.ScheduleExpressionValue("every day"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req := xattr.ValidateAttributeRequest{}
+			resp := xattr.ValidateAttributeResponse{}
+
+			test.val.ValidateAttribute(ctx, req, &resp)
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("resp.Diagnostics.HasError() = %t, want = %t", resp.Diagnostics.HasError(), test.expectError)
+			}
+		})
+	}
+}
+
+func TestScheduleExpressionStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.ScheduleExpression
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"equal": {
+			val1:   fwtypes.ScheduleExpressionValue("cron(0 12 ? * MON-FRI *)"),
+			val2:   fwtypes.ScheduleExpressionValue("cron(0 12 ? * MON-FRI *)"),
+			equals: true,
+		},
+		"case and whitespace, equal": {
+			val1:   fwtypes.ScheduleExpressionValue("cron(0 12 ? * MON-FRI *)"),
+			val2:   fwtypes.ScheduleExpressionValue("cron(0  12 ? * mon-fri *)"),
+			equals: true,
+		},
+		"not equal": {
+			val1:   fwtypes.ScheduleExpressionValue("rate(5 minutes)"),
+			val2:   fwtypes.ScheduleExpressionValue("rate(10 minutes)"),
+			equals: false,
+		},
+		"invalid": {
+			val1:   fwtypes.ScheduleExpressionValue("every day"),
+			val2:   fwtypes.ScheduleExpressionValue("every day"),
+			equals: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
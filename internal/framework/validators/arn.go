@@ -5,10 +5,13 @@ package validators
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 type arnValidator struct{}
@@ -39,3 +42,70 @@ func (validator arnValidator) ValidateString(ctx context.Context, request valida
 func ARN() validator.String {
 	return arnValidator{}
 }
+
+type arnOfTypeValidator struct {
+	arnTypes []itypes.ARNType
+}
+
+func (validator arnOfTypeValidator) Description(_ context.Context) string {
+	arnTypes := tfslices.ApplyToAll(validator.arnTypes, func(v itypes.ARNType) string {
+		return v.String()
+	})
+
+	return "An Amazon Resource Name of type " + strings.Join(arnTypes, ", ")
+}
+
+func (validator arnOfTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator arnOfTypeValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	a, err := arn.Parse(request.ConfigValue.ValueString())
+	if err != nil {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			"value must be a valid ARN",
+		))
+		return
+	}
+
+	if err := itypes.ValidateARNOfType(a, validator.arnTypes...); err != nil {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			"value must be a valid ARN: "+err.Error(),
+		))
+		return
+	}
+}
+
+// ARNOfType returns a string validator which ensures that any configured
+// attribute value is an ARN of one of the specified types whose Region, if any, is in its partition.
+func ARNOfType(arnTypes ...itypes.ARNType) validator.String {
+	return arnOfTypeValidator{
+		arnTypes: arnTypes,
+	}
+}
+
+// IAMRoleARN returns a string validator which ensures that any configured
+// attribute value is an IAM role ARN.
+func IAMRoleARN() validator.String {
+	return ARNOfType(itypes.ARNTypeIAMRole)
+}
+
+// KMSKeyARN returns a string validator which ensures that any configured
+// attribute value is a KMS key or alias ARN.
+func KMSKeyARN() validator.String {
+	return ARNOfType(itypes.ARNTypeKMSKey, itypes.ARNTypeKMSAlias)
+}
+
+// S3BucketARN returns a string validator which ensures that any configured
+// attribute value is an S3 bucket ARN.
+func S3BucketARN() validator.String {
+	return ARNOfType(itypes.ARNTypeS3Bucket)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 func TestARNValidator(t *testing.T) {
@@ -59,3 +60,78 @@ func TestARNValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestARNOfTypeValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		arnTypes    []itypes.ARNType
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:      types.StringUnknown(),
+			arnTypes: []itypes.ARNType{itypes.ARNTypeIAMRole},
+		},
+		"null String": {
+			val:      types.StringNull(),
+			arnTypes: []itypes.ARNType{itypes.ARNTypeIAMRole},
+		},
+		"valid role arn": {
+			val:      types.StringValue("arn:aws:iam::123456789012:role/example"), // lintignore:AWSAT005
+			arnTypes: []itypes.ARNType{itypes.ARNTypeIAMRole},
+		},
+		"invalid arn": {
+			val:         types.StringValue("arn"),
+			arnTypes:    []itypes.ARNType{itypes.ARNTypeIAMRole},
+			expectError: true,
+		},
+		"policy arn": {
+			val:         types.StringValue("arn:aws:iam::aws:policy/CloudWatchReadOnlyAccess"), // lintignore:AWSAT005
+			arnTypes:    []itypes.ARNType{itypes.ARNTypeIAMRole},
+			expectError: true,
+		},
+		"valid alias arn": {
+			val:      types.StringValue("arn:aws:kms:us-west-2:123456789012:alias/example"), // lintignore:AWSAT003,AWSAT005
+			arnTypes: []itypes.ARNType{itypes.ARNTypeKMSKey, itypes.ARNTypeKMSAlias},
+		},
+		"valid bucket arn": {
+			val:      types.StringValue("arn:aws:s3:::example"), // lintignore:AWSAT005
+			arnTypes: []itypes.ARNType{itypes.ARNTypeS3Bucket},
+		},
+		"access point arn": {
+			val:         types.StringValue("arn:aws:s3:us-west-2:123456789012:accesspoint/example"), // lintignore:AWSAT003,AWSAT005
+			arnTypes:    []itypes.ARNType{itypes.ARNTypeS3Bucket},
+			expectError: true,
+		},
+		"region not in partition": {
+			val:         types.StringValue("arn:aws-cn:kms:us-west-2:123456789012:key/example"), // lintignore:AWSAT003,AWSAT005
+			arnTypes:    []itypes.ARNType{itypes.ARNTypeKMSKey},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			fwvalidators.ARNOfType(test.arnTypes...).ValidateString(context.Background(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/cedar"
+)
+
+// cedarPolicyValidator validates that a string Attribute's value is syntactically valid Cedar.
+type cedarPolicyValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator cedarPolicyValidator) Description(_ context.Context) string {
+	return "value must be a valid Cedar policy"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator cedarPolicyValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (validator cedarPolicyValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	policies, err := cedar.Parse(request.ConfigValue.ValueString())
+
+	if err == nil && len(policies) != 1 {
+		err = fmt.Errorf("expected exactly one policy, got %d", len(policies))
+	}
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s\n\n%s", request.Path, validator.Description(ctx), request.ConfigValue.ValueString(), err),
+		)
+		return
+	}
+}
+
+// CedarPolicy returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents exactly one syntactically valid Cedar policy.
+//
+// Policy templates (with ?principal or ?resource slots) are not supported.
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func CedarPolicy() validator.String {
+	return cedarPolicyValidator{}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+func TestCedarPolicyValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"empty String": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"invalid String": {
+			val:         types.StringValue("test-value"),
+			expectError: true,
+		},
+		"valid policy": {
+			val: types.StringValue(`permit (principal, action == Action::"view", resource) when { context.mfa };`),
+		},
+		"missing semicolon": {
+			val:         types.StringValue(`permit (principal, action, resource)`),
+			expectError: true,
+		},
+		"multiple policies": {
+			val:         types.StringValue(`permit (principal, action, resource); forbid (principal, action, resource);`),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			fwvalidators.CedarPolicy().ValidateString(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), test.expectError; got != want {
+				t.Errorf("HasError() = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
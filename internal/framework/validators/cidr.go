@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -89,3 +90,43 @@ func (validator ipv6CIDRNetworkAddressValidator) ValidateString(ctx context.Cont
 func IPv6CIDRNetworkAddress() validator.String {
 	return ipv6CIDRNetworkAddressValidator{}
 }
+
+// ipAddressOrCIDRValidator validates that a string Attribute's value is a valid IP address or a valid CIDR that represents a network address.
+type ipAddressOrCIDRValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator ipAddressOrCIDRValidator) Description(_ context.Context) string {
+	return "value must be a valid IP address or a valid CIDR that represents a network address"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator ipAddressOrCIDRValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator ipAddressOrCIDRValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := itypes.ValidateIPAddressOrCIDR(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			request.ConfigValue.ValueString(),
+		))
+
+		return
+	}
+}
+
+// IPAddressOrCIDR returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid IPv4 or IPv6 address, or a valid IPv4 or IPv6 CIDR network address.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func IPAddressOrCIDR() validator.String {
+	return ipAddressOrCIDRValidator{}
+}
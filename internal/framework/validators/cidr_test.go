@@ -154,3 +154,72 @@ func TestIPv6CIDRNetworkAddressValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestIPAddressOrCIDRValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val                 types.String
+		expectedDiagnostics diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"invalid String": {
+			val: types.StringValue("test-value"),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test value must be a valid IP address or a valid CIDR that represents a network address, got: test-value`,
+				),
+			},
+		},
+		"valid IPv4 address": {
+			val: types.StringValue("10.2.2.1"),
+		},
+		"valid IPv4 CIDR": {
+			val: types.StringValue("10.2.2.0/24"),
+		},
+		"invalid IPv4 CIDR": {
+			val: types.StringValue("10.2.2.1/24"),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test value must be a valid IP address or a valid CIDR that represents a network address, got: 10.2.2.1/24`,
+				),
+			},
+		},
+		"valid IPv6 address": {
+			val: types.StringValue("2001:DB8::1"),
+		},
+		"valid IPv6 CIDR": {
+			val: types.StringValue("2001:DB8::/32"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			fwvalidators.IPAddressOrCIDR().ValidateString(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
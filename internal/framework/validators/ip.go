@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 // ipv4AddressValidator validates that a string Attribute's value is a valid IPv4 address.
@@ -114,3 +115,43 @@ func validateIPv6Address(value string) error {
 	}
 	return nil
 }
+
+// ipAddressValidator validates that a string Attribute's value is a valid IPv4 or IPv6 address.
+type ipAddressValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator ipAddressValidator) Description(_ context.Context) string {
+	return "value must be a valid IPv4 or IPv6 address"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator ipAddressValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := itypes.ValidateIPAddress(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			request.ConfigValue.ValueString(),
+		))
+
+		return
+	}
+}
+
+// IPAddress returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid IPv4 or IPv6 address.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func IPAddress() validator.String {
+	return ipAddressValidator{}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+// scheduleExpressionValidator validates that a string Attribute's value is a valid schedule expression of one of the allowed kinds.
+type scheduleExpressionValidator struct {
+	kinds []schedule.Kind
+}
+
+// Description describes the validation in plain text formatting.
+func (validator scheduleExpressionValidator) Description(_ context.Context) string {
+	kinds := tfslices.ApplyToAll(validator.kinds, func(v schedule.Kind) string {
+		return string(v) + "()"
+	})
+
+	return "value must be a valid " + strings.Join(kinds, ", ") + " schedule expression"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator scheduleExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (validator scheduleExpressionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	expression, err := schedule.Parse(request.ConfigValue.ValueString())
+
+	if err == nil && !slices.Contains(validator.kinds, expression.Kind()) {
+		err = fmt.Errorf("%s() schedule expressions are not supported", expression.Kind())
+	}
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s\n\n%s", request.Path, validator.Description(ctx), request.ConfigValue.ValueString(), err),
+		)
+		return
+	}
+}
+
+// ScheduleExpression returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid cron(), rate() or at() schedule expression of one of the specified kinds.
+//
+// If no kinds are specified, cron() and rate() expressions are allowed.
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func ScheduleExpression(kinds ...schedule.Kind) validator.String {
+	if len(kinds) == 0 {
+		kinds = []schedule.Kind{schedule.KindCron, schedule.KindRate}
+	}
+
+	return scheduleExpressionValidator{
+		kinds: kinds,
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+func TestScheduleExpressionValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		kinds       []schedule.Kind
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"invalid String": {
+			val:         types.StringValue("test-value"),
+			expectError: true,
+		},
+		"valid cron": {
+			val: types.StringValue("cron(0 12 ? * MON-FRI *)"),
+		},
+		"invalid cron": {
+			val:         types.StringValue("cron(0 12 1 * MON-FRI *)"),
+			expectError: true,
+		},
+		"valid rate": {
+			val: types.StringValue("rate(1 day)"),
+		},
+		"at not allowed by default": {
+			val:         types.StringValue("at(2025-10-01T12:30:00)"),
+			expectError: true,
+		},
+		"at allowed": {
+			val:   types.StringValue("at(2025-10-01T12:30:00)"),
+			kinds: []schedule.Kind{schedule.KindAt, schedule.KindCron, schedule.KindRate},
+		},
+		"rate not allowed": {
+			val:         types.StringValue("rate(1 day)"),
+			kinds:       []schedule.Kind{schedule.KindCron},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			fwvalidators.ScheduleExpression(test.kinds...).ValidateString(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), test.expectError; got != want {
+				t.Errorf("HasError() = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
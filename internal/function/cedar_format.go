@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/cedar"
+)
+
+var _ function.Function = cedarFormatFunction{}
+
+func NewCedarFormatFunction() function.Function {
+	return &cedarFormatFunction{}
+}
+
+type cedarFormatFunction struct{}
+
+func (f cedarFormatFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cedar_format"
+}
+
+func (f cedarFormatFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cedar_format Function",
+		MarkdownDescription: "Formats one or more Cedar policies into canonical text. " +
+			"This function can be used to keep Verified Permissions policy statements stable regardless of how they are written.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "Cedar policy text",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f cedarFormatFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := cedar.Format(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
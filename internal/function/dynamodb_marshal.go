@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/dynamodbjson"
+)
+
+var _ function.Function = dynamoDBMarshalFunction{}
+
+func NewDynamoDBMarshalFunction() function.Function {
+	return &dynamoDBMarshalFunction{}
+}
+
+type dynamoDBMarshalFunction struct{}
+
+func (f dynamoDBMarshalFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dynamodb_marshal"
+}
+
+func (f dynamoDBMarshalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "dynamodb_marshal Function",
+		MarkdownDescription: "Converts an object or map to a DynamoDB item in attribute value JSON format. " +
+			"The result can be used as the `item` of an `aws_dynamodb_table_item` resource.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "value",
+				MarkdownDescription: "Object or map to convert",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f dynamoDBMarshalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	var attrs map[string]attr.Value
+	switch v := arg.UnderlyingValue().(type) {
+	case basetypes.ObjectValue:
+		attrs = v.Attributes()
+	case basetypes.MapValue:
+		attrs = v.Elements()
+	}
+
+	if arg.IsUnderlyingValueNull() || attrs == nil {
+		resp.Error = function.NewFuncError("value must be a non-null object or map")
+		return
+	}
+
+	item := make(map[string]awstypes.AttributeValue, len(attrs))
+	for k, v := range attrs {
+		av, err := attributeValueFromValue(v)
+		if err != nil {
+			resp.Error = function.NewFuncError(fmt.Sprintf("%s: %s", k, err))
+			return
+		}
+
+		item[k] = av
+	}
+
+	result, err := dynamodbjson.EncodeItem(item)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, strings.TrimSuffix(result, "\n")))
+}
+
+// attributeValueFromValue converts a Terraform value to a DynamoDB attribute value.
+// Sets of strings and numbers are converted to string and number sets. Other sets, lists and tuples are converted to lists.
+func attributeValueFromValue(v attr.Value) (awstypes.AttributeValue, error) {
+	if v.IsNull() {
+		return &awstypes.AttributeValueMemberNULL{Value: true}, nil
+	}
+
+	switch v := v.(type) {
+	case basetypes.DynamicValue:
+		if v.IsUnderlyingValueNull() {
+			return &awstypes.AttributeValueMemberNULL{Value: true}, nil
+		}
+		return attributeValueFromValue(v.UnderlyingValue())
+	case basetypes.BoolValue:
+		return &awstypes.AttributeValueMemberBOOL{Value: v.ValueBool()}, nil
+	case basetypes.NumberValue:
+		return &awstypes.AttributeValueMemberN{Value: v.ValueBigFloat().Text('f', -1)}, nil
+	case basetypes.StringValue:
+		return &awstypes.AttributeValueMemberS{Value: v.ValueString()}, nil
+	case basetypes.ListValue:
+		return listAttributeValueFromValues(v.Elements())
+	case basetypes.TupleValue:
+		return listAttributeValueFromValues(v.Elements())
+	case basetypes.SetValue:
+		elements := v.Elements()
+		if len(elements) == 0 {
+			return nil, errors.New("DynamoDB sets cannot be empty")
+		}
+
+		switch v.ElementType(context.Background()).(type) {
+		case basetypes.NumberType:
+			return &awstypes.AttributeValueMemberNS{Value: tfslices.ApplyToAll(elements, func(v attr.Value) string {
+				return v.(basetypes.NumberValue).ValueBigFloat().Text('f', -1)
+			})}, nil
+		case basetypes.StringType:
+			return &awstypes.AttributeValueMemberSS{Value: tfslices.ApplyToAll(elements, func(v attr.Value) string {
+				return v.(basetypes.StringValue).ValueString()
+			})}, nil
+		}
+		return listAttributeValueFromValues(elements)
+	case basetypes.MapValue:
+		return mapAttributeValueFromValues(v.Elements())
+	case basetypes.ObjectValue:
+		return mapAttributeValueFromValues(v.Attributes())
+	}
+
+	return nil, fmt.Errorf("unsupported value type: %s", v.Type(context.Background()))
+}
+
+func listAttributeValueFromValues(elements []attr.Value) (awstypes.AttributeValue, error) {
+	v, err := tfslices.ApplyToAllWithError(elements, attributeValueFromValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &awstypes.AttributeValueMemberL{Value: v}, nil
+}
+
+func mapAttributeValueFromValues(elements map[string]attr.Value) (awstypes.AttributeValue, error) {
+	m := make(map[string]awstypes.AttributeValue, len(elements))
+	for k, v := range elements {
+		av, err := attributeValueFromValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+
+		m[k] = av
+	}
+
+	return &awstypes.AttributeValueMemberM{Value: m}, nil
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestDynamoDBMarshalFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDynamoDBMarshalFunctionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON("terraform_data.test", "output", `{
+  "id":      {"S": "item1"},
+  "count":   {"N": "42"},
+  "ratio":   {"N": "0.5"},
+  "enabled": {"BOOL": true},
+  "missing": {"NULL": true},
+  "tags":    {"SS": ["a", "b"]},
+  "scores":  {"NS": ["1", "2"]},
+  "list":    {"L": [{"S": "x"}, {"N": "1"}]},
+  "nested":  {"M": {"name": {"S": "n"}}}
+}`),
+				),
+			},
+		},
+	})
+}
+
+func TestDynamoDBMarshalFunction_notObject(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testDynamoDBMarshalFunctionConfig_notObject(),
+				ExpectError: regexache.MustCompile(`value[\s\n]*must[\s\n]*be[\s\n]*a[\s\n]*non-null[\s\n]*object[\s\n]*or[\s\n]*map`),
+			},
+		},
+	})
+}
+
+func TestDynamoDBMarshalFunction_emptySet(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testDynamoDBMarshalFunctionConfig_emptySet(),
+				ExpectError: regexache.MustCompile(`sets[\s\n]*cannot[\s\n]*be[\s\n]*empty`),
+			},
+		},
+	})
+}
+
+func testDynamoDBMarshalFunctionConfig_basic() string {
+	return `
+resource "terraform_data" "test" {
+  input = provider::aws::dynamodb_marshal({
+    id      = "item1"
+    count   = 42
+    ratio   = 0.5
+    enabled = true
+    missing = null
+    tags    = toset(["a", "b"])
+    scores  = toset([1, 2])
+    list    = ["x", 1]
+    nested = {
+      name = "n"
+    }
+  })
+}
+`
+}
+
+func testDynamoDBMarshalFunctionConfig_notObject() string {
+	return `
+output "test" {
+  value = provider::aws::dynamodb_marshal(["a", "b"])
+}
+`
+}
+
+func testDynamoDBMarshalFunctionConfig_emptySet() string {
+	return `
+output "test" {
+  value = provider::aws::dynamodb_marshal({
+    tags = toset([])
+  })
+}
+`
+}
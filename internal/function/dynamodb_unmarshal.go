@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/dynamodbjson"
+)
+
+var _ function.Function = dynamoDBUnmarshalFunction{}
+
+func NewDynamoDBUnmarshalFunction() function.Function {
+	return &dynamoDBUnmarshalFunction{}
+}
+
+type dynamoDBUnmarshalFunction struct{}
+
+func (f dynamoDBUnmarshalFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dynamodb_unmarshal"
+}
+
+func (f dynamoDBUnmarshalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "dynamodb_unmarshal Function",
+		MarkdownDescription: "Converts a DynamoDB item in attribute value JSON format to an object",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "item",
+				MarkdownDescription: "DynamoDB item in attribute value JSON format",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f dynamoDBUnmarshalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	item, err := dynamodbjson.DecodeItem(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, err := valueFromAttributeValue(&awstypes.AttributeValueMemberM{Value: item})
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.DynamicValue(result)))
+}
+
+// valueFromAttributeValue converts a DynamoDB attribute value to a Terraform value.
+// Maps are converted to objects and lists to tuples. Binary values are converted to base64-encoded strings.
+func valueFromAttributeValue(av awstypes.AttributeValue) (attr.Value, error) {
+	switch av := av.(type) {
+	case *awstypes.AttributeValueMemberB:
+		return types.StringValue(itypes.Base64Encode(av.Value)), nil
+	case *awstypes.AttributeValueMemberBOOL:
+		return types.BoolValue(av.Value), nil
+	case *awstypes.AttributeValueMemberBS:
+		return setValueFromStrings(tfslices.ApplyToAll(av.Value, itypes.Base64Encode))
+	case *awstypes.AttributeValueMemberL:
+		elements, err := tfslices.ApplyToAllWithError(av.Value, valueFromAttributeValue)
+		if err != nil {
+			return nil, err
+		}
+
+		elementTypes := tfslices.ApplyToAll(elements, func(v attr.Value) attr.Type {
+			return v.Type(context.Background())
+		})
+
+		return valueOrError(types.TupleValue(elementTypes, elements))
+	case *awstypes.AttributeValueMemberM:
+		attributes := make(map[string]attr.Value, len(av.Value))
+		attributeTypes := make(map[string]attr.Type, len(av.Value))
+		for k, v := range av.Value {
+			value, err := valueFromAttributeValue(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+
+			attributes[k] = value
+			attributeTypes[k] = value.Type(context.Background())
+		}
+
+		return valueOrError(types.ObjectValue(attributeTypes, attributes))
+	case *awstypes.AttributeValueMemberN:
+		return numberValueFromString(av.Value)
+	case *awstypes.AttributeValueMemberNS:
+		elements, err := tfslices.ApplyToAllWithError(av.Value, numberValueFromString)
+		if err != nil {
+			return nil, err
+		}
+
+		return valueOrError(types.SetValue(types.NumberType, elements))
+	case *awstypes.AttributeValueMemberNULL:
+		return types.StringNull(), nil
+	case *awstypes.AttributeValueMemberS:
+		return types.StringValue(av.Value), nil
+	case *awstypes.AttributeValueMemberSS:
+		return setValueFromStrings(av.Value)
+	}
+
+	return nil, fmt.Errorf("unexpected attribute type: %T", av)
+}
+
+func numberValueFromString(s string) (attr.Value, error) {
+	f, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number %q: %w", s, err)
+	}
+
+	return types.NumberValue(f), nil
+}
+
+func setValueFromStrings(s []string) (attr.Value, error) {
+	elements := tfslices.ApplyToAll(s, func(v string) attr.Value {
+		return types.StringValue(v)
+	})
+
+	return valueOrError(types.SetValue(types.StringType, elements))
+}
+
+// valueOrError returns the value constructed by a collection value function, or an error if construction failed.
+func valueOrError[T attr.Value](v T, diags diag.Diagnostics) (attr.Value, error) {
+	if diags.HasError() {
+		return nil, fwdiag.DiagnosticsError(diags)
+	}
+
+	return v, nil
+}
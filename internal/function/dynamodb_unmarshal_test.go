@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestDynamoDBUnmarshalFunction_basic(t *testing.T) {
+	t.Parallel()
+	item := `{
+  "id":      {"S": "item1"},
+  "count":   {"N": "42"},
+  "enabled": {"BOOL": true},
+  "blob":    {"B": "YmxvYg=="},
+  "missing": {"NULL": true},
+  "tags":    {"SS": ["b", "a"]},
+  "list":    {"L": [{"S": "x"}, {"N": "1"}]},
+  "nested":  {"M": {"name": {"S": "n"}}}
+}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDynamoDBUnmarshalFunctionConfig(item),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("id", "item1"),
+					resource.TestCheckOutput("count", "43"),
+					resource.TestCheckOutput("enabled", "true"),
+					resource.TestCheckOutput("blob", "YmxvYg=="),
+					resource.TestCheckOutput("missing", "true"),
+					resource.TestCheckOutput("tags", "a,b"),
+					resource.TestCheckOutput("list", "x"),
+					resource.TestCheckOutput("nested", "n"),
+				),
+			},
+		},
+	})
+}
+
+func TestDynamoDBUnmarshalFunction_roundTrip(t *testing.T) {
+	t.Parallel()
+	item := `{"id":{"S":"item1"},"count":{"N":"42"},"tags":{"SS":["a","b"]},"nested":{"M":{"list":{"L":[{"BOOL":false}]}}}}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "terraform_data" "test" {
+  input = provider::aws::dynamodb_marshal(provider::aws::dynamodb_unmarshal(%[1]q))
+}
+`, item),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON("terraform_data.test", "output", item),
+				),
+			},
+		},
+	})
+}
+
+func TestDynamoDBUnmarshalFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testDynamoDBUnmarshalFunctionConfig(`{"id":"item1"}`),
+				ExpectError: regexache.MustCompile(`unexpected[\s\n]*raw[\s\n]*attribute[\s\n]*type`),
+			},
+		},
+	})
+}
+
+func testDynamoDBUnmarshalFunctionConfig(item string) string {
+	return fmt.Sprintf(`
+locals {
+  item = provider::aws::dynamodb_unmarshal(%[1]q)
+}
+
+output "id" {
+  value = local.item.id
+}
+
+output "count" {
+  value = local.item.count + 1
+}
+
+output "enabled" {
+  value = local.item.enabled
+}
+
+output "blob" {
+  value = local.item.blob
+}
+
+output "missing" {
+  value = local.item.missing == null
+}
+
+output "tags" {
+  value = join(",", sort(local.item.tags))
+}
+
+output "list" {
+  value = local.item.list[0]
+}
+
+output "nested" {
+  value = local.item.nested.name
+}
+`, item)
+}
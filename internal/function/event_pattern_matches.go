@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/eventpattern"
+)
+
+var _ function.Function = eventPatternMatchesFunction{}
+
+func NewEventPatternMatchesFunction() function.Function {
+	return &eventPatternMatchesFunction{}
+}
+
+type eventPatternMatchesFunction struct{}
+
+func (f eventPatternMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "event_pattern_matches"
+}
+
+func (f eventPatternMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "event_pattern_matches Function",
+		MarkdownDescription: "Reports whether an event matches an EventBridge event pattern, without sending the event to AWS. " +
+			"This function can be used to test the routing of EventBridge rules and Pipes filters.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "Event pattern in JSON format",
+			},
+			function.StringParameter{
+				Name:                "event",
+				MarkdownDescription: "Event in JSON format",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f eventPatternMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, event string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &event))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := eventpattern.Matches(pattern, event)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+const testEventPatternMatchesFunctionEvent = `{
+  "source": "aws.ec2",
+  "detail-type": "EC2 Instance State-change Notification",
+  "detail": {
+    "instance-id": "i-1234567890abcdef0",
+    "state": "stopped"
+  }
+}`
+
+func TestEventPatternMatchesFunction_match(t *testing.T) {
+	t.Parallel()
+	pattern := `{"source": ["aws.ec2"], "detail": {"state": [{"anything-but": "running"}]}}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEventPatternMatchesFunctionConfig(pattern, testEventPatternMatchesFunctionEvent),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestEventPatternMatchesFunction_noMatch(t *testing.T) {
+	t.Parallel()
+	pattern := `{"source": ["aws.ec2"], "detail": {"state": [{"prefix": "run"}]}}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEventPatternMatchesFunctionConfig(pattern, testEventPatternMatchesFunctionEvent),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestEventPatternMatchesFunction_invalidPattern(t *testing.T) {
+	t.Parallel()
+	pattern := `{"source": "aws.ec2"}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEventPatternMatchesFunctionConfig(pattern, testEventPatternMatchesFunctionEvent),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*an[\s\n]*object[\s\n]*or[\s\n]*an[\s\n]*array`),
+			},
+		},
+	})
+}
+
+func testEventPatternMatchesFunctionConfig(pattern, event string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::event_pattern_matches(%[1]q, %[2]q)
+}`, pattern, event)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+const (
+	// scheduleNextTimesMaxCount is the maximum number of fire times that can be requested
+	scheduleNextTimesMaxCount = 100
+)
+
+var _ function.Function = scheduleNextTimesFunction{}
+
+func NewScheduleNextTimesFunction() function.Function {
+	return &scheduleNextTimesFunction{}
+}
+
+type scheduleNextTimesFunction struct{}
+
+func (f scheduleNextTimesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_next_times"
+}
+
+func (f scheduleNextTimesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "schedule_next_times Function",
+		MarkdownDescription: "Computes the next fire times of a cron(), rate() or at() schedule expression. " +
+			"This function can be used to check schedules used by EventBridge rules, EventBridge Scheduler and other services.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression",
+			},
+			function.StringParameter{
+				Name:                "after",
+				MarkdownDescription: "RFC 3339 timestamp after which to compute fire times. The timestamp's offset is used as the schedule's time zone",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: fmt.Sprintf("Maximum number of fire times to compute (1-%d)", scheduleNextTimesMaxCount),
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f scheduleNextTimesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression, after string
+	var count int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression, &after, &count))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := scheduleNextTimes(expression, after, count)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// scheduleNextTimes returns the RFC 3339 formatted fire times of a schedule expression
+func scheduleNextTimes(expression, after string, count int64) ([]string, error) {
+	if count < 1 || count > scheduleNextTimesMaxCount {
+		return nil, fmt.Errorf("count must be between 1 and %d", scheduleNextTimesMaxCount)
+	}
+
+	t, err := time.Parse(time.RFC3339, after)
+	if err != nil {
+		return nil, fmt.Errorf("after must be an RFC 3339 timestamp: %w", err)
+	}
+
+	e, err := schedule.Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(e.Next(t, int(count)), func(v time.Time) string {
+		return v.Format(time.RFC3339)
+	}), nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestScheduleNextTimesFunction_cron(t *testing.T) {
+	t.Parallel()
+	expression := "cron(0 9 ? * MON-FRI *)"
+	after := "2025-01-01T10:00:00Z"
+	expected := "2025-01-02T09:00:00Z,2025-01-03T09:00:00Z,2025-01-06T09:00:00Z"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleNextTimesFunctionConfig(expression, after, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleNextTimesFunction_rateWithOffset(t *testing.T) {
+	t.Parallel()
+	expression := "rate(90 minutes)"
+	after := "2025-01-01T10:00:00+02:00"
+	expected := "2025-01-01T11:30:00+02:00,2025-01-01T13:00:00+02:00"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleNextTimesFunctionConfig(expression, after, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleNextTimesFunction_invalidExpression(t *testing.T) {
+	t.Parallel()
+	expression := "cron(0 12 * * ?)"
+	after := "2025-01-01T10:00:00Z"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleNextTimesFunctionConfig(expression, after, 1),
+				ExpectError: regexache.MustCompile(`expected[\s\n]*6[\s\n]*fields`),
+			},
+		},
+	})
+}
+
+func TestScheduleNextTimesFunction_invalidCount(t *testing.T) {
+	t.Parallel()
+	expression := "rate(1 day)"
+	after := "2025-01-01T10:00:00Z"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleNextTimesFunctionConfig(expression, after, 0),
+				ExpectError: regexache.MustCompile(`count[\s\n]*must[\s\n]*be[\s\n]*between`),
+			},
+		},
+	})
+}
+
+func testScheduleNextTimesFunctionConfig(expression, after string, count int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::schedule_next_times(%[1]q, %[2]q, %[3]d))
+}`, expression, after, count)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+// The AWS Service Authorization Reference in machine-readable form.
+// See https://docs.aws.amazon.com/service-authorization/latest/reference/service-reference.html.
+const serviceReferenceURL = `https://servicereference.us-east-1.amazonaws.com/`
+
+var (
+	filename = flag.String("Filename", "service_authorization_gen.json", "name of the generated catalog file")
+	services = flag.String("Services", "", "comma-separated list of service prefixes to include in the catalog")
+)
+
+type serviceReferenceIndexEntry struct {
+	Service string `json:"service"`
+	URL     string `json:"url"`
+}
+
+type serviceReference struct {
+	Name    string `json:"Name"`
+	Actions []struct {
+		Name string `json:"Name"`
+	} `json:"Actions"`
+	ConditionKeys []struct {
+		Name string `json:"Name"`
+	} `json:"ConditionKeys"`
+	Resources []struct {
+		ARNFormats []string `json:"ARNFormats"`
+	} `json:"Resources"`
+}
+
+// catalogService must be kept in sync with serviceAuthorization in internal/service/iam/policy_lint.go.
+type catalogService struct {
+	Actions       []string `json:"actions"`
+	ARNFormats    []string `json:"arnFormats"`
+	ConditionKeys []string `json:"conditionKeys"`
+}
+
+func main() {
+	flag.Parse()
+
+	g := common.NewGenerator()
+
+	g.Infof("Generating internal/service/iam/%s", *filename)
+
+	var index []serviceReferenceIndexEntry
+	if err := getJSON(serviceReferenceURL, &index); err != nil {
+		g.Fatalf("reading service reference index: %s", err)
+	}
+
+	include := strings.Split(*services, ",")
+	catalog := make(map[string]catalogService)
+
+	for _, entry := range index {
+		if !slices.Contains(include, entry.Service) {
+			continue
+		}
+
+		var reference serviceReference
+		if err := getJSON(entry.URL, &reference); err != nil {
+			g.Fatalf("reading service reference (%s): %s", entry.Service, err)
+		}
+
+		service := catalogService{
+			Actions:       []string{},
+			ARNFormats:    []string{},
+			ConditionKeys: []string{},
+		}
+		for _, v := range reference.Actions {
+			service.Actions = append(service.Actions, v.Name)
+		}
+		for _, v := range reference.Resources {
+			service.ARNFormats = append(service.ARNFormats, v.ARNFormats...)
+		}
+		for _, v := range reference.ConditionKeys {
+			service.ConditionKeys = append(service.ConditionKeys, v.Name)
+		}
+		slices.Sort(service.Actions)
+		slices.Sort(service.ARNFormats)
+		service.ARNFormats = slices.Compact(service.ARNFormats)
+		slices.Sort(service.ConditionKeys)
+
+		catalog[entry.Service] = service
+	}
+
+	for _, v := range include {
+		if _, ok := catalog[v]; !ok {
+			g.Fatalf("service %q not found in service reference", v)
+		}
+	}
+
+	body, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		g.Fatalf("encoding catalog: %s", err)
+	}
+
+	d := g.NewUnformattedFileDestination(*filename)
+
+	if err := d.BufferBytes(append(body, '\n')); err != nil {
+		g.Fatalf("buffering catalog: %s", err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("writing catalog: %s", err)
+	}
+}
+
+func getJSON(url string, v any) error {
+	response, err := http.Get(url) //nolint:noctx // generator
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, response.Status)
+	}
+
+	return json.NewDecoder(response.Body).Decode(v)
+}
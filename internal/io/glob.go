@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package io
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// ValidateGlob checks that pattern is a valid glob pattern for MatchGlob.
+func ValidateGlob(pattern string) error {
+	for _, v := range strings.Split(pattern, "/") {
+		if _, err := path.Match(v, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MatchGlob reports whether the slash-separated path name matches the glob pattern.
+// In addition to the syntax supported by path.Match, a "**" path segment matches zero or more path segments.
+func MatchGlob(pattern, name string) (bool, error) {
+	if err := ValidateGlob(pattern); err != nil {
+		return false, err
+	}
+
+	return matchGlobSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchGlobSegments(pattern, name []string) (bool, error) {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			// Collapse consecutive "**" segments.
+			for len(pattern) > 0 && pattern[0] == "**" {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 0 {
+				return true, nil
+			}
+
+			for i := range name {
+				if ok, err := matchGlobSegments(pattern, name[i:]); ok || err != nil {
+					return ok, err
+				}
+			}
+
+			return false, nil
+		}
+
+		if len(name) == 0 {
+			return false, nil
+		}
+
+		ok, err := path.Match(pattern[0], name[0])
+		if err != nil || !ok {
+			return false, err
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0, nil
+}
+
+// ListFiles returns the slash-separated paths, relative to dir, of the regular files in the directory tree rooted at dir.
+// If includes is not empty only files matching at least one include pattern are returned. Files matching any exclude pattern are omitted.
+// Symbolic links to files are followed; symbolic links to directories are not.
+// The result is sorted lexically.
+func ListFiles(dir string, includes, excludes []string) ([]string, error) {
+	dir, err := homedir.Expand(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range slices.Concat(includes, excludes) {
+		if err := ValidateGlob(v); err != nil {
+			return nil, &fs.PathError{Op: "glob", Path: v, Err: err}
+		}
+	}
+
+	matchesAny := func(patterns []string, name string) bool {
+		for _, v := range patterns {
+			if ok, _ := MatchGlob(v, name); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	var files []string
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		name := filepath.ToSlash(rel)
+
+		if d.IsDir() {
+			if matchesAny(excludes, name) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if d.Type()&fs.ModeSymlink != 0 {
+			fi, err := os.Stat(p)
+			if err != nil {
+				return err
+			}
+			if !fi.Mode().IsRegular() {
+				return nil
+			}
+		} else if !d.Type().IsRegular() {
+			return nil
+		}
+
+		if len(includes) > 0 && !matchesAny(includes, name) {
+			return nil
+		}
+		if matchesAny(excludes, name) {
+			return nil
+		}
+
+		files = append(files, name)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(files)
+
+	return files, nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package io
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestMatchGlob(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{pattern: "*.py", name: "index.py", expected: true},
+		{pattern: "*.py", name: "lib/index.py", expected: false},
+		{pattern: "lib/*.py", name: "lib/index.py", expected: true},
+		{pattern: "**/*.py", name: "index.py", expected: true},
+		{pattern: "**/*.py", name: "lib/a/b/index.py", expected: true},
+		{pattern: "**/*.py", name: "lib/index.pyc", expected: false},
+		{pattern: "lib/**", name: "lib/a/b/index.py", expected: true},
+		{pattern: "lib/**", name: "src/index.py", expected: false},
+		{pattern: "lib/**/test_*.py", name: "lib/test_a.py", expected: true},
+		{pattern: "lib/**/test_*.py", name: "lib/a/test_a.py", expected: true},
+		{pattern: "lib/**/test_*.py", name: "lib/a/a.py", expected: false},
+		{pattern: "**/__pycache__/**", name: "a/__pycache__/b.pyc", expected: true},
+		{pattern: "?.txt", name: "a.txt", expected: true},
+		{pattern: "[ab].txt", name: "c.txt", expected: false},
+		{pattern: "README.md", name: "README.md", expected: true},
+		{pattern: "README.md", name: "docs/README.md", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		got, err := MatchGlob(testCase.pattern, testCase.name)
+		if err != nil {
+			t.Errorf("MatchGlob(%q, %q): unexpected error: %s", testCase.pattern, testCase.name, err)
+			continue
+		}
+
+		if got != testCase.expected {
+			t.Errorf("MatchGlob(%q, %q) = %t, want %t", testCase.pattern, testCase.name, got, testCase.expected)
+		}
+	}
+}
+
+func TestValidateGlob(t *testing.T) {
+	t.Parallel()
+
+	for _, pattern := range []string{"[", "**/[", "a/[b"} {
+		if err := ValidateGlob(pattern); err == nil {
+			t.Errorf("ValidateGlob(%q): expected error", pattern)
+		}
+	}
+
+	for _, pattern := range []string{"*", "**", "**/*.js", "a/[bc]/d"} {
+		if err := ValidateGlob(pattern); err != nil {
+			t.Errorf("ValidateGlob(%q): unexpected error: %s", pattern, err)
+		}
+	}
+}
+
+func TestListFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for _, name := range []string{"index.js", "package.json", "lib/util.js", "lib/util.test.js", "node_modules/a/index.js"} {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := map[string]struct {
+		includes []string
+		excludes []string
+		expected []string
+	}{
+		"all": {
+			expected: []string{"index.js", "lib/util.js", "lib/util.test.js", "node_modules/a/index.js", "package.json"},
+		},
+		"includes": {
+			includes: []string{"**/*.js"},
+			expected: []string{"index.js", "lib/util.js", "lib/util.test.js", "node_modules/a/index.js"},
+		},
+		"excludes": {
+			excludes: []string{"node_modules", "**/*.test.js"},
+			expected: []string{"index.js", "lib/util.js", "package.json"},
+		},
+		"includes and excludes": {
+			includes: []string{"lib/**"},
+			excludes: []string{"**/*.test.js"},
+			expected: []string{"lib/util.js"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ListFiles(dir, testCase.includes, testCase.excludes)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("ListFiles() = %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+
+	if _, err := ListFiles(dir, []string{"["}, nil); err == nil {
+		t.Error("expected error for invalid pattern")
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"cmp"
+	"encoding/json"
+	"reflect"
+	"slices"
+)
+
+// Normalizer rewrites a decoded JSON document into a canonical form.
+// Normalizers operate on the generic representation produced by decoding into an `any`
+// (`map[string]any`, `[]any`, `string`, `float64`, `bool` and `nil`) and may modify the document in place.
+type Normalizer func(any) any
+
+// NormalizeString decodes the JSON document in the given string, applies the normalizer and re-encodes the result.
+func NormalizeString(s string, normalizer Normalizer) (string, error) {
+	var v any
+	if err := DecodeFromString(s, &v); err != nil {
+		return "", err
+	}
+
+	if normalizer != nil {
+		v = normalizer(v)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// EqualStringsNormalized returns whether the JSON documents in the given strings are equal
+// once both have been normalized.
+func EqualStringsNormalized(s1, s2 string, normalizer Normalizer) bool {
+	var v1 any
+	if err := DecodeFromString(s1, &v1); err != nil {
+		return false
+	}
+
+	var v2 any
+	if err := DecodeFromString(s2, &v2); err != nil {
+		return false
+	}
+
+	if normalizer != nil {
+		v1, v2 = normalizer(v1), normalizer(v2)
+	}
+
+	return reflect.DeepEqual(v1, v2)
+}
+
+// NormalizeEventPattern is a Normalizer for Amazon EventBridge event patterns.
+// Every array in an event pattern is a set of alternative matchers, so arrays are sorted.
+// Numeric matchers are the exception: their operands are ordered pairs and are left untouched.
+func NormalizeEventPattern(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if key == "numeric" {
+				continue
+			}
+			v[key] = NormalizeEventPattern(value)
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = NormalizeEventPattern(value)
+		}
+		sortValues(v)
+	}
+
+	return v
+}
+
+const (
+	stateMachineQueryLanguageJSONPath = "JSONPath"
+)
+
+// NormalizeStateMachineDefinition is a Normalizer for AWS Step Functions state machine definitions (Amazon States Language).
+// `ErrorEquals` arrays in `Retry` and `Catch` are sets and are sorted, and `QueryLanguage` fields that
+// repeat the inherited (or default `JSONPath`) query language are removed.
+func NormalizeStateMachineDefinition(v any) any {
+	normalizeStateMachine(v, stateMachineQueryLanguageJSONPath)
+
+	return v
+}
+
+func normalizeStateMachine(v any, inheritedQueryLanguage string) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return
+	}
+
+	queryLanguage := elideQueryLanguage(m, inheritedQueryLanguage)
+
+	states, ok := m["States"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	for _, state := range states {
+		state, ok := state.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		stateQueryLanguage := elideQueryLanguage(state, queryLanguage)
+
+		for _, key := range []string{"Retry", "Catch"} {
+			if handlers, ok := state[key].([]any); ok {
+				for _, handler := range handlers {
+					if handler, ok := handler.(map[string]any); ok {
+						if errorEquals, ok := handler["ErrorEquals"].([]any); ok {
+							sortValues(errorEquals)
+						}
+					}
+				}
+			}
+		}
+
+		// Nested state machines.
+		if branches, ok := state["Branches"].([]any); ok {
+			for _, branch := range branches {
+				normalizeStateMachine(branch, stateQueryLanguage)
+			}
+		}
+		for _, key := range []string{"ItemProcessor", "Iterator"} {
+			if processor, ok := state[key]; ok {
+				normalizeStateMachine(processor, stateQueryLanguage)
+			}
+		}
+	}
+}
+
+// elideQueryLanguage removes the `QueryLanguage` field from m if it matches the inherited query language
+// and returns the effective query language.
+func elideQueryLanguage(m map[string]any, inherited string) string {
+	queryLanguage, ok := m["QueryLanguage"].(string)
+	if !ok {
+		return inherited
+	}
+
+	if queryLanguage == inherited {
+		delete(m, "QueryLanguage")
+	}
+
+	return queryLanguage
+}
+
+var (
+	// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html.
+	dashboardBodyDefaults = map[string]any{
+		"periodOverride": "auto",
+	}
+	dashboardWidgetDefaults = map[string]any{
+		"height": float64(6),
+		"width":  float64(6),
+	}
+	dashboardMetricWidgetPropertiesDefaults = map[string]any{
+		"period":  float64(300),
+		"stacked": false,
+		"view":    "timeSeries",
+	}
+)
+
+// NormalizeDashboardBody is a Normalizer for Amazon CloudWatch dashboard bodies.
+// `null` values and fields that repeat the service default are removed; widget order is significant and is preserved.
+func NormalizeDashboardBody(v any) any {
+	v = removeNullValues(v)
+
+	m, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+
+	elideDefaultValues(m, dashboardBodyDefaults)
+
+	if widgets, ok := m["widgets"].([]any); ok {
+		for _, widget := range widgets {
+			widget, ok := widget.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			elideDefaultValues(widget, dashboardWidgetDefaults)
+
+			if properties, ok := widget["properties"].(map[string]any); ok && widget["type"] == "metric" {
+				elideDefaultValues(properties, dashboardMetricWidgetPropertiesDefaults)
+			}
+		}
+	}
+
+	return m
+}
+
+// elideDefaultValues removes the fields of m whose values equal their defaults.
+func elideDefaultValues(m map[string]any, defaults map[string]any) {
+	for key, value := range defaults {
+		if v, ok := m[key]; ok && reflect.DeepEqual(v, value) {
+			delete(m, key)
+		}
+	}
+}
+
+// sortValues sorts a slice of decoded JSON values by their canonical encoding.
+func sortValues(s []any) {
+	slices.SortStableFunc(s, func(a, b any) int {
+		return cmp.Compare(canonical(a), canonical(b))
+	})
+}
+
+func canonical(v any) string {
+	b, _ := json.Marshal(v) // Map keys are sorted.
+
+	return string(b)
+}
+
+func removeNullValues(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if value == nil {
+				delete(v, key)
+				continue
+			}
+			v[key] = removeNullValues(value)
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = removeNullValues(value)
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+func TestEqualStringsNormalized(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName   string
+		x, y       string
+		normalizer json.Normalizer
+		wantEqual  bool
+	}{
+		{
+			testName: "invalid JSON",
+			x:        `test`,
+			y:        `{}`,
+		},
+		{
+			testName:  "no normalizer",
+			x:         `{"A": [1, 2]}`,
+			y:         `{ "A": [1,2] }`,
+			wantEqual: true,
+		},
+		{
+			testName: "no normalizer, array order",
+			x:        `{"A": [1, 2]}`,
+			y:        `{"A": [2, 1]}`,
+		},
+		{
+			testName:   "event pattern, array order",
+			x:          `{"source": ["aws.ec2", "aws.s3"], "detail": {"state": [{"prefix": "run"}, "stopped"]}}`,
+			y:          `{"detail": {"state": ["stopped", {"prefix": "run"}]}, "source": ["aws.s3", "aws.ec2"]}`,
+			normalizer: json.NormalizeEventPattern,
+			wantEqual:  true,
+		},
+		{
+			testName:   "event pattern, numeric operands are ordered",
+			x:          `{"detail": {"c": [{"numeric": [">", 0, "<=", 5]}]}}`,
+			y:          `{"detail": {"c": [{"numeric": [">", 5, "<=", 0]}]}}`,
+			normalizer: json.NormalizeEventPattern,
+		},
+		{
+			testName:   "event pattern, different values",
+			x:          `{"source": ["aws.ec2"]}`,
+			y:          `{"source": ["aws.s3"]}`,
+			normalizer: json.NormalizeEventPattern,
+		},
+		{
+			testName: "state machine definition, ErrorEquals order and default QueryLanguage",
+			x: `{"StartAt": "A", "QueryLanguage": "JSONPath", "States": {"A": {"Type": "Task", "Resource": "arn", "End": true,
+				"Retry": [{"ErrorEquals": ["States.Timeout", "States.TaskFailed"]}]}}}`,
+			y: `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn", "End": true, "QueryLanguage": "JSONPath",
+				"Retry": [{"ErrorEquals": ["States.TaskFailed", "States.Timeout"]}]}}}`,
+			normalizer: json.NormalizeStateMachineDefinition,
+			wantEqual:  true,
+		},
+		{
+			testName:   "state machine definition, explicit QueryLanguage under JSONata",
+			x:          `{"StartAt": "A", "QueryLanguage": "JSONata", "States": {"A": {"Type": "Pass", "End": true, "QueryLanguage": "JSONPath"}}}`,
+			y:          `{"StartAt": "A", "QueryLanguage": "JSONata", "States": {"A": {"Type": "Pass", "End": true}}}`,
+			normalizer: json.NormalizeStateMachineDefinition,
+		},
+		{
+			testName: "state machine definition, Catch order is significant",
+			x: `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn", "End": true,
+				"Catch": [{"ErrorEquals": ["A"], "Next": "X"}, {"ErrorEquals": ["B"], "Next": "Y"}]}}}`,
+			y: `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn", "End": true,
+				"Catch": [{"ErrorEquals": ["B"], "Next": "Y"}, {"ErrorEquals": ["A"], "Next": "X"}]}}}`,
+			normalizer: json.NormalizeStateMachineDefinition,
+		},
+		{
+			testName:   "dashboard body, null values",
+			x:          `{"widgets": [{"type": "text", "properties": {"markdown": "Hello"}, "x": null}]}`,
+			y:          `{"widgets": [{"type": "text", "properties": {"markdown": "Hello"}}]}`,
+			normalizer: json.NormalizeDashboardBody,
+			wantEqual:  true,
+		},
+		{
+			testName: "dashboard body, default values",
+			x: `{"periodOverride": "auto", "widgets": [{"type": "metric", "width": 6, "height": 6,
+				"properties": {"metrics": [["AWS/EC2", "CPUUtilization"]], "period": 300, "stacked": false, "view": "timeSeries"}}]}`,
+			y:          `{"widgets": [{"type": "metric", "properties": {"metrics": [["AWS/EC2", "CPUUtilization"]]}}]}`,
+			normalizer: json.NormalizeDashboardBody,
+			wantEqual:  true,
+		},
+		{
+			testName:   "dashboard body, non-default values",
+			x:          `{"widgets": [{"type": "metric", "width": 12, "properties": {"view": "singleValue"}}]}`,
+			y:          `{"widgets": [{"type": "metric", "properties": {}}]}`,
+			normalizer: json.NormalizeDashboardBody,
+		},
+		{
+			testName:   "dashboard body, widget order",
+			x:          `{"widgets": [{"type": "text"}, {"type": "metric"}]}`,
+			y:          `{"widgets": [{"type": "metric"}, {"type": "text"}]}`,
+			normalizer: json.NormalizeDashboardBody,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := json.EqualStringsNormalized(testCase.x, testCase.y, testCase.normalizer), testCase.wantEqual; got != want {
+				t.Errorf("EqualStringsNormalized(%q, %q) = %t, want %t", testCase.x, testCase.y, got, want)
+			}
+		})
+	}
+}
+
+func TestNormalizeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName   string
+		input      string
+		normalizer json.Normalizer
+		wantOutput string
+		wantErr    bool
+	}{
+		{
+			testName: "invalid JSON",
+			input:    `test`,
+			wantErr:  true,
+		},
+		{
+			testName:   "no normalizer",
+			input:      `{"B": 1, "A": [2, 1]}`,
+			wantOutput: `{"A":[2,1],"B":1}`,
+		},
+		{
+			testName:   "event pattern",
+			input:      `{"source": ["aws.s3", "aws.ec2"]}`,
+			normalizer: json.NormalizeEventPattern,
+			wantOutput: `{"source":["aws.ec2","aws.s3"]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := json.NormalizeString(testCase.input, testCase.normalizer)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("NormalizeString(%q) err %t, want %t", testCase.input, got, want)
+			}
+			if err == nil {
+				if got, want := output, testCase.wantOutput; got != want {
+					t.Errorf("NormalizeString(%q) = %q, want %q", testCase.input, got, want)
+				}
+			}
+		})
+	}
+}
@@ -168,6 +168,10 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"validate_policies": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Validate IAM policies, resource policies and service control policies with IAM Access Analyzer when planning changes. Policies with errors fail the plan.",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
@@ -542,6 +546,11 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCedarFormatFunction,
+		tffunction.NewDynamoDBMarshalFunction,
+		tffunction.NewDynamoDBUnmarshalFunction,
+		tffunction.NewEventPatternMatchesFunction,
+		tffunction.NewScheduleNextTimesFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -255,6 +255,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"validate_policies": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Validate IAM policies, resource policies and service control policies with IAM Access Analyzer when planning changes. " +
+					"Policies with errors fail the plan.",
+			},
 		},
 
 		// Data sources and resources implemented using Terraform Plugin SDK
@@ -500,6 +506,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		TokenBucketRateLimiterCapacity: d.Get("token_bucket_rate_limiter_capacity").(int),
 		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
+		ValidatePolicies:               d.Get("validate_policies").(bool),
 	}
 
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// Backoff returns the delay before the retry that follows the specified (1-based) attempt.
+type Backoff interface {
+	Delay(attempt int) time.Duration
+}
+
+type BackoffFunc func(int) time.Duration
+
+func (f BackoffFunc) Delay(attempt int) time.Duration {
+	return f(attempt)
+}
+
+// ConstantBackoff returns a Backoff that always delays for the specified duration.
+func ConstantBackoff(d time.Duration) Backoff {
+	return BackoffFunc(func(int) time.Duration {
+		return d
+	})
+}
+
+// ExponentialBackoff returns a Backoff that delays for minDelay * multiplier**(attempt-1).
+// If maxDelay is positive, delays are capped at maxDelay.
+func ExponentialBackoff(minDelay time.Duration, multiplier float64, maxDelay time.Duration) Backoff {
+	return BackoffFunc(func(attempt int) time.Duration {
+		d := time.Duration(float64(minDelay) * math.Pow(multiplier, float64(attempt-1)))
+
+		if maxDelay > 0 && (d > maxDelay || d < 0) {
+			d = maxDelay
+		}
+
+		return d
+	})
+}
+
+// WithJitter returns a Backoff that randomly subtracts up to the specified fraction of each delay.
+func WithJitter(b Backoff, fraction float64) Backoff {
+	return BackoffFunc(func(attempt int) time.Duration {
+		mult := 1 - fraction*rand.Float64() // #nosec G404 -- Jitter does not need a cryptographically secure random number.
+
+		return time.Duration(float64(b.Delay(attempt)) * mult)
+	})
+}
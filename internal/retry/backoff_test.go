@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+)
+
+func TestExponentialBackoff(t *testing.T) {
+	t.Parallel()
+
+	backoff := retry.ExponentialBackoff(500*time.Millisecond, 2, 10*time.Second)
+
+	for attempt, want := range map[int]time.Duration{
+		1:  500 * time.Millisecond,
+		2:  time.Second,
+		3:  2 * time.Second,
+		5:  8 * time.Second,
+		6:  10 * time.Second,
+		99: 10 * time.Second,
+	} {
+		if got := backoff.Delay(attempt); got != want {
+			t.Errorf("Delay(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+}
+
+func TestWithJitter(t *testing.T) {
+	t.Parallel()
+
+	const delay = time.Second
+	backoff := retry.WithJitter(retry.ConstantBackoff(delay), 0.4)
+
+	for range 100 {
+		if got := backoff.Delay(1); got > delay || got < delay*6/10 {
+			t.Fatalf("Delay(1) = %s, want between %s and %s", got, delay*6/10, delay)
+		}
+	}
+}
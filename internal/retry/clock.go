@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Clock is the source of time for retry loops.
+// Tests can inject a FakeClock so that retry loops run without real delays.
+type Clock interface {
+	Now() time.Time
+	// Sleep pauses for the specified duration or until the context is done, whichever occurs first.
+	Sleep(context.Context, time.Duration)
+	// WithDeadline returns a copy of the context that is done when the clock reaches the deadline.
+	WithDeadline(context.Context, time.Time) (context.Context, context.CancelFunc)
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Sleep(ctx context.Context, d time.Duration) {
+	sleep(ctx, d)
+}
+
+func (realClock) WithDeadline(ctx context.Context, d time.Time) (context.Context, context.CancelFunc) {
+	return context.WithDeadline(ctx, d)
+}
+
+// FakeClock is a Clock whose time only advances when Sleep is called.
+type FakeClock struct {
+	mu        sync.Mutex
+	now       time.Time
+	sleeps    []time.Duration
+	deadlines []*fakeDeadlineContext
+}
+
+var _ Clock = (*FakeClock)(nil)
+
+// NewFakeClock returns a new FakeClock set to the specified time.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Sleep advances the clock by the specified duration without blocking.
+func (c *FakeClock) Sleep(ctx context.Context, d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	c.sleeps = append(c.sleeps, d)
+	c.expireDeadlines()
+}
+
+// WithDeadline returns a copy of the context that is done when Sleep advances the clock to the deadline.
+func (c *FakeClock) WithDeadline(ctx context.Context, d time.Time) (context.Context, context.CancelFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	inner, cancel := context.WithCancel(ctx)
+	dctx := &fakeDeadlineContext{Context: inner, cancel: cancel, deadline: d}
+	c.deadlines = append(c.deadlines, dctx)
+	c.expireDeadlines()
+
+	return dctx, cancel
+}
+
+// expireDeadlines cancels the deadline contexts whose deadline has been reached.
+// The caller must hold c.mu.
+func (c *FakeClock) expireDeadlines() {
+	c.deadlines = slices.DeleteFunc(c.deadlines, func(dctx *fakeDeadlineContext) bool {
+		if c.now.Before(dctx.deadline) {
+			return false
+		}
+
+		dctx.expire()
+
+		return true
+	})
+}
+
+// Sleeps returns the durations of all calls to Sleep.
+func (c *FakeClock) Sleeps() []time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]time.Duration(nil), c.sleeps...)
+}
+
+// fakeDeadlineContext is a context whose deadline is determined by a FakeClock.
+type fakeDeadlineContext struct {
+	context.Context
+	cancel   context.CancelFunc
+	deadline time.Time
+
+	mu      sync.Mutex
+	expired bool
+}
+
+func (c *fakeDeadlineContext) Deadline() (time.Time, bool) {
+	return c.deadline, true
+}
+
+func (c *fakeDeadlineContext) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.expired {
+		return context.DeadlineExceeded
+	}
+
+	return c.Context.Err()
+}
+
+func (c *fakeDeadlineContext) expire() {
+	c.mu.Lock()
+	if c.Context.Err() == nil {
+		c.expired = true
+	}
+	c.mu.Unlock()
+
+	c.cancel()
+}
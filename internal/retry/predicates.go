@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// A predicate returns true if the operation should be retried, along with the error
+// to return should no further attempts be made.
+
+// WhenError returns a predicate that retries an operation when f returns true for the operation's error.
+func WhenError[T any](f func(error) bool) PredicateFunc[T] {
+	return func(_ T, err error) (bool, error) {
+		return err != nil && f(err), err
+	}
+}
+
+// WhenErrCodeEquals retries an operation when it returns one of the specified AWS error codes.
+func WhenErrCodeEquals[T any](codes ...string) PredicateFunc[T] {
+	return WhenError[T](func(err error) bool {
+		return tfawserr.ErrCodeEquals(err, codes...)
+	})
+}
+
+// WhenErrCodeContains retries an operation when it returns an AWS error containing the specified code.
+func WhenErrCodeContains[T any](code string) PredicateFunc[T] {
+	return WhenError[T](func(err error) bool {
+		return tfawserr.ErrCodeContains(err, code)
+	})
+}
+
+// WhenErrMessageContains retries an operation when it returns an AWS error with the specified code containing the specified message.
+func WhenErrMessageContains[T any](code, message string) PredicateFunc[T] {
+	return WhenError[T](func(err error) bool {
+		return tfawserr.ErrMessageContains(err, code, message)
+	})
+}
+
+// WhenIsA retries an operation when it returns an error of type E.
+func WhenIsA[T any, E error]() PredicateFunc[T] {
+	return WhenError[T](errs.IsA[E])
+}
+
+// WhenIsAErrorMessageContains retries an operation when it returns an error of type E containing the specified message.
+func WhenIsAErrorMessageContains[T any, E errs.ErrorWithErrorMessage](needle string) PredicateFunc[T] {
+	return WhenError[T](func(err error) bool {
+		return errs.IsAErrorMessageContains[E](err, needle)
+	})
+}
+
+// WhenNotFound retries an operation when it returns a retry.NotFoundError.
+func WhenNotFound[T any]() PredicateFunc[T] {
+	return WhenError[T](notFound)
+}
+
+// UntilEqual retries an operation until it returns a value equal to want.
+func UntilEqual[T comparable](want T) PredicateFunc[T] {
+	return func(t T, err error) (bool, error) {
+		if err != nil {
+			return false, err
+		}
+
+		if t != want {
+			return true, fmt.Errorf("output = %v, want %v", t, want)
+		}
+
+		return false, nil
+	}
+}
+
+// Or returns a predicate that retries an operation when any of the specified predicates does.
+func Or[T any](predicates ...PredicateFunc[T]) PredicateFunc[T] {
+	return func(t T, err error) (bool, error) {
+		for _, predicate := range predicates {
+			if retry, err := predicate(t, err); retry {
+				return true, err
+			}
+		}
+
+		return false, err
+	}
+}
+
+func notFound(err error) bool {
+	var e *sdkretry.NotFoundError // nosemgrep:ci.is-not-found-error
+	return errors.As(err, &e)
+}
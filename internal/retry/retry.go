@@ -24,6 +24,9 @@ var defaultOptions = Options{
 	BackoffMultiplier:  1.3,
 }
 
+// defaultJitter is the maximum fraction of each delay that is randomly subtracted.
+const defaultJitter = 0.4
+
 // Retry holds state for managing retry loops with exponential backoff and jitter.
 type Retry struct {
 	options Options
@@ -70,8 +73,7 @@ var rng = rand.New(rand.NewSource(time.Now().UnixNano()))
 // Sleeps for a random duration close to the specified value or until context is done,
 // whichever occurs first.
 func randomizedSleep(ctx context.Context, d time.Duration) {
-	const jitter = 0.4
-	mult := 1 - jitter*rng.Float64() // Subtract up to 40%.
+	mult := 1 - defaultJitter*rng.Float64() // Subtract up to 40%.
 	sleep(ctx, time.Duration(float64(d)*mult))
 }
 
@@ -9,7 +9,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Op[T any] interface {
@@ -32,10 +32,47 @@ func (f PredicateFunc[T]) Invoke(t T, err error) (bool, error) {
 	return f(t, err)
 }
 
+// Attempt describes a completed attempt of an operation.
+type Attempt struct {
+	Number  int           // 1-based attempt number
+	Retry   bool          // Whether the operation will be retried
+	Err     error         // Error returned by the operation's predicate
+	Elapsed time.Duration // Time elapsed since the operation started
+}
+
+// TimeoutError is returned when an operation does not complete before its timeout elapses.
+type TimeoutError struct {
+	Attempts  int
+	LastError error
+	Timeout   time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	msg := fmt.Sprintf("timeout while waiting %s (%d attempts)", e.Timeout, e.Attempts)
+
+	if e.LastError != nil {
+		msg += ": " + e.LastError.Error()
+	}
+
+	return msg
+}
+
+func (e *TimeoutError) Unwrap() []error {
+	if e.LastError == nil {
+		return []error{context.DeadlineExceeded}
+	}
+
+	return []error{context.DeadlineExceeded, e.LastError}
+}
+
 type operation[T any] struct {
 	op                Op[T]
 	predicate         Predicate[T]
 	transformRunError func(error) error
+	backoff           Backoff
+	clock             Clock
+	delay             time.Duration
+	onAttempt         func(context.Context, Attempt)
 }
 
 // Operation returns a new wrapper on top of the specified function.
@@ -48,21 +85,49 @@ func Operation[T any](op OpFunc[T]) operation[T] {
 		}),
 		// The default error transformer does nothing.
 		transformRunError: func(err error) error { return err },
+		backoff:           WithJitter(ExponentialBackoff(defaultOptions.BackoffMinDuration, defaultOptions.BackoffMultiplier, 0), defaultJitter),
+		clock:             realClock{},
 	}
 }
 
 func (o operation[T]) withPredicate(predicate Predicate[T]) operation[T] {
-	return operation[T]{op: o.op, predicate: predicate, transformRunError: o.transformRunError}
+	o.predicate = predicate
+	return o
 }
 
 func (o operation[T]) withTransformRunError(f func(error) error) operation[T] {
-	return operation[T]{op: o.op, predicate: o.predicate, transformRunError: f}
+	o.transformRunError = f
+	return o
 }
 
 func (o operation[T]) If(predicate PredicateFunc[T]) operation[T] {
 	return o.withPredicate(predicate)
 }
 
+// WithBackoff sets the policy used to delay retries.
+func (o operation[T]) WithBackoff(backoff Backoff) operation[T] {
+	o.backoff = backoff
+	return o
+}
+
+// WithClock sets the source of time, e.g. a FakeClock in tests.
+func (o operation[T]) WithClock(clock Clock) operation[T] {
+	o.clock = clock
+	return o
+}
+
+// WithDelay sets the time to wait before the first attempt.
+func (o operation[T]) WithDelay(delay time.Duration) operation[T] {
+	o.delay = delay
+	return o
+}
+
+// OnAttempt registers a function that is called after each attempt.
+func (o operation[T]) OnAttempt(f func(context.Context, Attempt)) operation[T] {
+	o.onAttempt = f
+	return o
+}
+
 // UntilFoundN retries an operation if it returns a retry.NotFoundError.
 func (o operation[T]) UntilFoundN(continuousTargetOccurence int) operation[T] {
 	if continuousTargetOccurence < 1 {
@@ -82,7 +147,7 @@ func (o operation[T]) UntilFoundN(continuousTargetOccurence int) operation[T] {
 			return true, nil
 		}
 
-		if tfresource.NotFound(err) { // nosemgrep:ci.semgrep.errors.notfound-without-err-checks
+		if notFound(err) {
 			targetOccurence = 0
 
 			return true, err
@@ -100,7 +165,7 @@ func (o operation[T]) UntilNotFound() operation[T] {
 			return true, nil
 		}
 
-		if tfresource.NotFound(err) { // nosemgrep:ci.semgrep.errors.notfound-without-err-checks
+		if notFound(err) {
 			return false, nil
 		}
 
@@ -119,18 +184,66 @@ func (o operation[T]) UntilNotFound() operation[T] {
 }
 
 // Run retries an operation until the timeout elapses or predicate indicates otherwise.
+// Retries are delayed by the operation's backoff policy, shortened so that a final attempt
+// is made when the timeout elapses.
+// Each attempt's context is done when the timeout elapses.
+// If the timeout elapses, the returned error is a *TimeoutError.
 func (o operation[T]) Run(ctx context.Context, timeout time.Duration) (T, error) {
-	ctx, cancel := context.WithTimeout(ctx, timeout)
+	var zero T
+	start := o.clock.Now()
+	deadline := start.Add(timeout)
+
+	opCtx, cancel := o.clock.WithDeadline(ctx, deadline)
 	defer cancel()
 
-	for r := Begin(); r.Continue(ctx); {
-		t, err := o.op.Invoke(ctx)
+	if o.delay > 0 {
+		o.clock.Sleep(ctx, o.delay)
+	}
+
+	for n := 1; ; n++ {
+		if err := ctx.Err(); err != nil {
+			return zero, o.transformRunError(err)
+		}
+
+		t, err := o.op.Invoke(opCtx)
+		retry, err := o.predicate.Invoke(t, err)
+
+		attempt := Attempt{
+			Number:  n,
+			Retry:   retry,
+			Err:     err,
+			Elapsed: o.clock.Now().Sub(start),
+		}
+		o.attempted(ctx, attempt)
+
+		if !retry {
+			// An attempt cut short by the deadline is a timeout.
+			if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil && opCtx.Err() != nil {
+				return zero, o.transformRunError(&TimeoutError{Attempts: n, LastError: err, Timeout: timeout})
+			}
 
-		if retry, err := o.predicate.Invoke(t, err); !retry {
 			return t, err
 		}
+
+		remaining := deadline.Sub(o.clock.Now())
+		if remaining <= 0 || opCtx.Err() != nil {
+			return zero, o.transformRunError(&TimeoutError{Attempts: n, LastError: err, Timeout: timeout})
+		}
+
+		o.clock.Sleep(ctx, min(o.backoff.Delay(n), remaining))
+	}
+}
+
+func (o operation[T]) attempted(ctx context.Context, attempt Attempt) {
+	if attempt.Retry {
+		tflog.Debug(ctx, "Retrying operation", map[string]any{
+			"attempt": attempt.Number,
+			"elapsed": attempt.Elapsed.String(),
+			"error":   fmt.Sprint(attempt.Err),
+		})
 	}
 
-	var t T
-	return t, o.transformRunError(ctx.Err())
+	if o.onAttempt != nil {
+		o.onAttempt(ctx, attempt)
+	}
 }
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+)
+
+func TestOperationRun(t *testing.T) {
+	t.Parallel()
+
+	errRetryable := &smithy.GenericAPIError{Code: "RetryableError"}
+	errOther := errors.New("other")
+
+	testCases := map[string]struct {
+		results       []error
+		predicate     retry.PredicateFunc[int]
+		backoff       retry.Backoff
+		timeout       time.Duration
+		wantAttempts  int
+		wantSleeps    []time.Duration
+		wantErr       error
+		wantTimeout   bool
+		wantLastError error
+	}{
+		"success": {
+			results:      []error{nil},
+			predicate:    retry.WhenErrCodeEquals[int]("RetryableError"),
+			timeout:      time.Minute,
+			wantAttempts: 1,
+		},
+		"non-retryable error": {
+			results:      []error{errOther},
+			predicate:    retry.WhenErrCodeEquals[int]("RetryableError"),
+			timeout:      time.Minute,
+			wantAttempts: 1,
+			wantErr:      errOther,
+		},
+		"retryable then success": {
+			results:      []error{errRetryable, errRetryable, nil},
+			predicate:    retry.WhenErrCodeEquals[int]("RetryableError"),
+			backoff:      retry.ExponentialBackoff(time.Second, 2, 0),
+			timeout:      time.Minute,
+			wantAttempts: 3,
+			wantSleeps:   []time.Duration{time.Second, 2 * time.Second},
+		},
+		"timeout makes final attempt at deadline": {
+			results:       []error{errRetryable, errRetryable, errRetryable, errRetryable},
+			predicate:     retry.WhenErrCodeEquals[int]("RetryableError"),
+			backoff:       retry.ConstantBackoff(4 * time.Second),
+			timeout:       10 * time.Second,
+			wantAttempts:  4,
+			wantSleeps:    []time.Duration{4 * time.Second, 4 * time.Second, 2 * time.Second},
+			wantTimeout:   true,
+			wantLastError: errRetryable,
+		},
+		"or": {
+			results:      []error{errOther, errRetryable, nil},
+			predicate:    retry.Or(retry.WhenErrCodeEquals[int]("RetryableError"), retry.WhenError[int](func(err error) bool { return errors.Is(err, errOther) })),
+			backoff:      retry.ConstantBackoff(time.Second),
+			timeout:      time.Minute,
+			wantAttempts: 3,
+			wantSleeps:   []time.Duration{time.Second, time.Second},
+		},
+		"not found": {
+			results:      []error{&sdkretry.NotFoundError{}, nil},
+			predicate:    retry.WhenNotFound[int](),
+			backoff:      retry.ConstantBackoff(time.Second),
+			timeout:      time.Minute,
+			wantAttempts: 2,
+			wantSleeps:   []time.Duration{time.Second},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			clock := retry.NewFakeClock(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC))
+			var attempts []retry.Attempt
+
+			op := retry.Operation(func(context.Context) (int, error) {
+				err := testCase.results[min(len(attempts), len(testCase.results)-1)]
+				return len(attempts), err
+			}).If(testCase.predicate).WithClock(clock).OnAttempt(func(_ context.Context, attempt retry.Attempt) {
+				attempts = append(attempts, attempt)
+			})
+			if testCase.backoff != nil {
+				op = op.WithBackoff(testCase.backoff)
+			}
+
+			_, err := op.Run(ctx, testCase.timeout)
+
+			if got, want := len(attempts), testCase.wantAttempts; got != want {
+				t.Errorf("attempts = %d, want %d", got, want)
+			}
+
+			if diff := cmp.Diff(clock.Sleeps(), testCase.wantSleeps); diff != "" {
+				t.Errorf("unexpected sleeps diff (+wanted, -got): %s", diff)
+			}
+
+			if testCase.wantTimeout {
+				var timeoutErr *retry.TimeoutError
+				if !errors.As(err, &timeoutErr) {
+					t.Fatalf("expected TimeoutError, got %v", err)
+				}
+				if !errors.Is(err, context.DeadlineExceeded) {
+					t.Errorf("expected error to wrap context.DeadlineExceeded")
+				}
+				if got, want := timeoutErr.LastError, testCase.wantLastError; !errors.Is(got, want) {
+					t.Errorf("LastError = %v, want %v", got, want)
+				}
+				return
+			}
+
+			if got, want := err, testCase.wantErr; !errors.Is(got, want) {
+				t.Errorf("err = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestOperationRunUntilEqual(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	clock := retry.NewFakeClock(time.Now())
+	values := []string{"CREATING", "CREATING", "AVAILABLE"}
+	i := 0
+
+	got, err := retry.Operation(func(context.Context) (*string, error) {
+		v := values[i]
+		i++
+		return aws.String(v), nil
+	}).If(func(v *string, err error) (bool, error) {
+		return retry.UntilEqual("AVAILABLE")(aws.ToString(v), err)
+	}).WithClock(clock).Run(ctx, time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := aws.ToString(got), "AVAILABLE"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := len(clock.Sleeps()), 2; got != want {
+		t.Errorf("sleeps = %d, want %d", got, want)
+	}
+}
+
+func TestOperationRunUntilNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	clock := retry.NewFakeClock(time.Now())
+
+	_, err := retry.Operation(func(context.Context) (int, error) {
+		return 0, nil
+	}).UntilNotFound().WithClock(clock).WithBackoff(retry.ConstantBackoff(time.Second)).Run(ctx, 5*time.Second)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected error to wrap context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestOperationRunAttemptDeadline(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	clock := retry.NewFakeClock(time.Now())
+	var opErr error
+	attempts := 0
+
+	_, err := retry.Operation(func(ctx context.Context) (int, error) {
+		attempts++
+		// Simulate an attempt that hangs beyond the timeout.
+		clock.Sleep(ctx, time.Hour)
+		opErr = ctx.Err()
+		return 0, opErr
+	}).If(retry.WhenErrCodeEquals[int]("RetryableError")).WithClock(clock).Run(ctx, 10*time.Second)
+
+	if got, want := attempts, 1; got != want {
+		t.Errorf("attempts = %d, want %d", got, want)
+	}
+	if !errors.Is(opErr, context.DeadlineExceeded) {
+		t.Errorf("expected operation context to be done with context.DeadlineExceeded, got %v", opErr)
+	}
+
+	var timeoutErr *retry.TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected TimeoutError, got %v", err)
+	}
+}
+
+func TestOperationRunContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := retry.Operation(func(context.Context) (int, error) {
+		return 0, nil
+	}).WithClock(retry.NewFakeClock(time.Now())).Run(ctx, time.Minute)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// PolicyValidation validates a resource's policy attribute with IAM Access Analyzer
+// when the validate_policies provider configuration value is true.
+type PolicyValidation struct {
+	// Attribute is the name of the attribute containing the policy document.
+	Attribute string
+	// PolicyType is the type of the policy document.
+	PolicyType awstypes.PolicyType
+	// ResourceType is the optional type of resource to which a resource policy is attached.
+	ResourceType awstypes.ValidatePolicyResourceType
+	// Enabled optionally returns whether the resource's policy document should be validated.
+	Enabled func(d interface{ Get(string) interface{} }) bool
+}
+
+// CustomizeDiff fails the plan if IAM Access Analyzer returns ERROR findings for a changed policy document.
+func (v *PolicyValidation) CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !meta.(*conns.AWSClient).ValidatePolicies(ctx) {
+		return nil
+	}
+
+	if !d.HasChange(v.Attribute) || !d.NewValueKnown(v.Attribute) {
+		return nil
+	}
+
+	policy := d.Get(v.Attribute).(string)
+	if policy == "" || (v.Enabled != nil && !v.Enabled(d)) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	findings, err := validatePolicy(ctx, conn, v.input(policy))
+
+	if err != nil {
+		return fmt.Errorf("validating %s: %w", v.Attribute, err)
+	}
+
+	var errs []error
+	for _, finding := range findings {
+		if finding.FindingType == awstypes.ValidatePolicyFindingTypeError {
+			errs = append(errs, errors.New(policyValidationFindingString(finding)))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("%s: IAM Access Analyzer policy validation errors:\n%w", v.Attribute, err)
+	}
+
+	return nil
+}
+
+// Warnings returns IAM Access Analyzer SECURITY_WARNING findings for a changed policy document as warning diagnostics.
+func (v *PolicyValidation) Warnings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !meta.(*conns.AWSClient).ValidatePolicies(ctx) {
+		return diags
+	}
+
+	if !d.HasChange(v.Attribute) {
+		return diags
+	}
+
+	policy := d.Get(v.Attribute).(string)
+	if policy == "" || (v.Enabled != nil && !v.Enabled(d)) {
+		return diags
+	}
+
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	findings, err := validatePolicy(ctx, conn, v.input(policy))
+
+	if err != nil {
+		return sdkdiag.AppendWarningf(diags, "validating %s: %s", v.Attribute, err)
+	}
+
+	for _, finding := range findings {
+		if finding.FindingType == awstypes.ValidatePolicyFindingTypeSecurityWarning {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       "IAM Access Analyzer policy validation security warning",
+				Detail:        policyValidationFindingString(finding),
+				AttributePath: cty.GetAttrPath(v.Attribute),
+			})
+		}
+	}
+
+	return diags
+}
+
+func (v *PolicyValidation) input(policy string) *accessanalyzer.ValidatePolicyInput {
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(policy),
+		PolicyType:     v.PolicyType,
+	}
+
+	if v.ResourceType != "" {
+		input.ValidatePolicyResourceType = v.ResourceType
+	}
+
+	return input
+}
+
+func validatePolicy(ctx context.Context, conn *accessanalyzer.Client, input *accessanalyzer.ValidatePolicyInput) ([]awstypes.ValidatePolicyFinding, error) {
+	var output []awstypes.ValidatePolicyFinding
+
+	pages := accessanalyzer.NewValidatePolicyPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
+
+func policyValidationFindingString(finding awstypes.ValidatePolicyFinding) string {
+	s := fmt.Sprintf("%s: %s", aws.ToString(finding.IssueCode), aws.ToString(finding.FindingDetails))
+
+	if v := aws.ToString(finding.LearnMoreLink); v != "" {
+		s += fmt.Sprintf(" (%s)", v)
+	}
+
+	return s
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_accessanalyzer_policy_validation", name="Policy Validation")
+func newPolicyValidationDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &policyValidationDataSource{}, nil
+}
+
+type policyValidationDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *policyValidationDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_accessanalyzer_policy_validation"
+}
+
+func (d *policyValidationDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"existing_policy_document": schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Optional:   true,
+			},
+			"fail_on_error": schema.BoolAttribute{
+				Optional: true,
+			},
+			"findings":   framework.DataSourceComputedListOfObjectAttribute[policyValidationFindingModel](ctx),
+			names.AttrID: framework.IDAttribute(),
+			"no_new_access_message": schema.StringAttribute{
+				Computed: true,
+			},
+			"no_new_access_result": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CheckNoNewAccessResult](),
+				Computed:   true,
+			},
+			"policy_document": schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+			"policy_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PolicyType](),
+				Required:   true,
+			},
+			"validate_policy_resource_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ValidatePolicyResourceType](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *policyValidationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data policyValidationDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().AccessAnalyzerClient(ctx)
+
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument:             fwflex.StringFromFramework(ctx, data.PolicyDocument),
+		PolicyType:                 data.PolicyType.ValueEnum(),
+		ValidatePolicyResourceType: data.ValidatePolicyResourceType.ValueEnum(),
+	}
+
+	findings, err := validatePolicy(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("validating IAM Access Analyzer policy", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, findings, &data.Findings)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for _, finding := range findings {
+		switch finding.FindingType {
+		case awstypes.ValidatePolicyFindingTypeError:
+			if data.FailOnError.ValueBool() {
+				response.Diagnostics.AddAttributeError(path.Root("policy_document"), "IAM Access Analyzer policy validation error", policyValidationFindingString(finding))
+			}
+		case awstypes.ValidatePolicyFindingTypeSecurityWarning:
+			response.Diagnostics.AddAttributeWarning(path.Root("policy_document"), "IAM Access Analyzer policy validation security warning", policyValidationFindingString(finding))
+		}
+	}
+
+	data.NoNewAccessMessage = types.StringNull()
+	data.NoNewAccessResult = fwtypes.StringEnumNull[awstypes.CheckNoNewAccessResult]()
+
+	if !data.ExistingPolicyDocument.IsNull() {
+		var policyType awstypes.AccessCheckPolicyType
+
+		switch v := data.PolicyType.ValueEnum(); v {
+		case awstypes.PolicyTypeIdentityPolicy:
+			policyType = awstypes.AccessCheckPolicyTypeIdentityPolicy
+		case awstypes.PolicyTypeResourcePolicy:
+			policyType = awstypes.AccessCheckPolicyTypeResourcePolicy
+		default:
+			response.Diagnostics.AddAttributeError(path.Root("existing_policy_document"), "Invalid Attribute Combination", fmt.Sprintf("existing_policy_document cannot be specified when policy_type is %s", v))
+
+			return
+		}
+
+		input := &accessanalyzer.CheckNoNewAccessInput{
+			ExistingPolicyDocument: fwflex.StringFromFramework(ctx, data.ExistingPolicyDocument),
+			NewPolicyDocument:      fwflex.StringFromFramework(ctx, data.PolicyDocument),
+			PolicyType:             policyType,
+		}
+
+		output, err := conn.CheckNoNewAccess(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError("checking IAM Access Analyzer policy for new access", err.Error())
+
+			return
+		}
+
+		data.NoNewAccessMessage = fwflex.StringToFramework(ctx, output.Message)
+		data.NoNewAccessResult = fwtypes.StringEnumValue(output.Result)
+
+		if output.Result == awstypes.CheckNoNewAccessResultFail {
+			response.Diagnostics.AddAttributeWarning(path.Root("policy_document"), "IAM Access Analyzer policy grants new access", aws.ToString(output.Message))
+		}
+	}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().Region(ctx))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type policyValidationDataSourceModel struct {
+	ExistingPolicyDocument     fwtypes.IAMPolicy                                             `tfsdk:"existing_policy_document"`
+	FailOnError                types.Bool                                                    `tfsdk:"fail_on_error"`
+	Findings                   fwtypes.ListNestedObjectValueOf[policyValidationFindingModel] `tfsdk:"findings"`
+	ID                         types.String                                                  `tfsdk:"id"`
+	NoNewAccessMessage         types.String                                                  `tfsdk:"no_new_access_message"`
+	NoNewAccessResult          fwtypes.StringEnum[awstypes.CheckNoNewAccessResult]           `tfsdk:"no_new_access_result"`
+	PolicyDocument             fwtypes.IAMPolicy                                             `tfsdk:"policy_document"`
+	PolicyType                 fwtypes.StringEnum[awstypes.PolicyType]                       `tfsdk:"policy_type"`
+	ValidatePolicyResourceType fwtypes.StringEnum[awstypes.ValidatePolicyResourceType]       `tfsdk:"validate_policy_resource_type"`
+}
+
+type policyValidationFindingModel struct {
+	FindingDetails types.String                                           `tfsdk:"finding_details"`
+	FindingType    fwtypes.StringEnum[awstypes.ValidatePolicyFindingType] `tfsdk:"finding_type"`
+	IssueCode      types.String                                           `tfsdk:"issue_code"`
+	LearnMoreLink  types.String                                           `tfsdk:"learn_more_link"`
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "0"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "no_new_access_result"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_error(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig_error(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.finding_type", "ERROR"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.issue_code", "MISSING_ACTION"),
+				),
+			},
+			{
+				Config:      testAccPolicyValidationDataSourceConfig_error(true),
+				ExpectError: regexache.MustCompile(`MISSING_ACTION`),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_existingPolicyDocument(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig_existingPolicyDocument,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "no_new_access_message"),
+					resource.TestCheckResourceAttr(dataSourceName, "no_new_access_result", "PASS"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyValidationDataSourceConfig_basic = `
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_type = "IDENTITY_POLICY"
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:GetObject"
+      Resource = "arn:aws:s3:::example/*"
+    }]
+  })
+}
+`
+
+func testAccPolicyValidationDataSourceConfig_error(failOnError bool) string {
+	return fmt.Sprintf(`
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_type   = "IDENTITY_POLICY"
+  fail_on_error = %[1]t
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Resource = "arn:aws:s3:::example/*"
+    }]
+  })
+}
+`, failOnError)
+}
+
+const testAccPolicyValidationDataSourceConfig_existingPolicyDocument = `
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_type = "IDENTITY_POLICY"
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:GetObject"
+      Resource = "arn:aws:s3:::example/*"
+    }]
+  })
+
+  existing_policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:*"
+      Resource = "arn:aws:s3:::example/*"
+    }]
+  })
+}
+`
@@ -1128,7 +1128,7 @@ func resourceConnectorProfile() *schema.Resource {
 												names.AttrRoleARN: {
 													Type:         schema.TypeString,
 													Required:     true,
-													ValidateFunc: verify.ValidARN,
+													ValidateFunc: verify.ValidIAMRoleARN,
 												},
 											},
 										},
@@ -1330,7 +1330,7 @@ func resourceFlow() *schema.Resource {
 									names.AttrRoleARN: {
 										Type:             schema.TypeString,
 										Required:         true,
-										ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+										ValidateDiagFunc: validation.ToDiagFunc(verify.ValidIAMRoleARN),
 									},
 									"table_prefix": {
 										Type:     schema.TypeString,
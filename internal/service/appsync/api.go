@@ -0,0 +1,435 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_appsync_api", name="API")
+// @Tags(identifierAttribute="arn")
+func newAPIResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &apiResource{}
+
+	return r, nil
+}
+
+type apiResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*apiResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_appsync_api"
+}
+
+func (r *apiResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	authModeBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[authModeModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"auth_type": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.AuthenticationType](),
+						Required:   true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_id":      framework.IDAttribute(),
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"dns": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 50),
+				},
+			},
+			"owner_contact": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 250),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"waf_web_acl_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"xray_enabled": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"event_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[eventConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"auth_provider": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[authProviderModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(5),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"auth_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AuthenticationType](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"cognito_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[cognitoConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"app_id_client_regex": schema.StringAttribute{
+													Optional: true,
+												},
+												"aws_region": schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrUserPoolID: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"lambda_authorizer_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaAuthorizerConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"authorizer_result_ttl_in_seconds": schema.Int64Attribute{
+													Optional: true,
+													Computed: true,
+												},
+												"authorizer_uri": schema.StringAttribute{
+													Required: true,
+												},
+												"identity_validation_expression": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+									"openid_connect_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[openIDConnectConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"auth_ttl": schema.Int64Attribute{
+													Optional: true,
+													Computed: true,
+												},
+												names.AttrClientID: schema.StringAttribute{
+													Optional: true,
+												},
+												"iat_ttl": schema.Int64Attribute{
+													Optional: true,
+													Computed: true,
+												},
+												names.AttrIssuer: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"connection_auth_mode":        authModeBlock(),
+						"default_publish_auth_mode":   authModeBlock(),
+						"default_subscribe_auth_mode": authModeBlock(),
+						"log_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[eventLogConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"cloudwatch_logs_role_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									"log_level": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.EventLogLevel](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *apiResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data apiResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppSyncClient(ctx)
+
+	name := data.Name.ValueString()
+	var input appsync.CreateApiInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateApi(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating AppSync API (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.Api, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ID = data.APIID
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *apiResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data apiResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppSyncClient(ctx)
+
+	output, err := findAPIByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading AppSync API (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *apiResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new apiResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppSyncClient(ctx)
+
+	if !new.EventConfig.Equal(old.EventConfig) ||
+		!new.Name.Equal(old.Name) ||
+		!new.OwnerContact.Equal(old.OwnerContact) {
+		var input appsync.UpdateApiInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdateApi(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating AppSync API (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output.Api, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *apiResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data apiResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppSyncClient(ctx)
+
+	_, err := conn.DeleteApi(ctx, &appsync.DeleteApiInput{
+		ApiId: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting AppSync API (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *apiResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findAPIByID(ctx context.Context, conn *appsync.Client, id string) (*awstypes.Api, error) {
+	input := &appsync.GetApiInput{
+		ApiId: aws.String(id),
+	}
+
+	output, err := conn.GetApi(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Api == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Api, nil
+}
+
+type apiResourceModel struct {
+	APIARN       types.String                                      `tfsdk:"arn"`
+	APIID        types.String                                      `tfsdk:"api_id"`
+	DNS          fwtypes.MapOfString                               `tfsdk:"dns"`
+	EventConfig  fwtypes.ListNestedObjectValueOf[eventConfigModel] `tfsdk:"event_config"`
+	ID           types.String                                      `tfsdk:"id"`
+	Name         types.String                                      `tfsdk:"name"`
+	OwnerContact types.String                                      `tfsdk:"owner_contact"`
+	Tags         tftags.Map                                        `tfsdk:"tags"`
+	TagsAll      tftags.Map                                        `tfsdk:"tags_all"`
+	WAFWebACLARN types.String                                      `tfsdk:"waf_web_acl_arn"`
+	XrayEnabled  types.Bool                                        `tfsdk:"xray_enabled"`
+}
+
+type eventConfigModel struct {
+	AuthProviders             fwtypes.ListNestedObjectValueOf[authProviderModel]   `tfsdk:"auth_provider"`
+	ConnectionAuthModes       fwtypes.ListNestedObjectValueOf[authModeModel]       `tfsdk:"connection_auth_mode"`
+	DefaultPublishAuthModes   fwtypes.ListNestedObjectValueOf[authModeModel]       `tfsdk:"default_publish_auth_mode"`
+	DefaultSubscribeAuthModes fwtypes.ListNestedObjectValueOf[authModeModel]       `tfsdk:"default_subscribe_auth_mode"`
+	LogConfig                 fwtypes.ListNestedObjectValueOf[eventLogConfigModel] `tfsdk:"log_config"`
+}
+
+type authProviderModel struct {
+	AuthType               fwtypes.StringEnum[awstypes.AuthenticationType]              `tfsdk:"auth_type"`
+	CognitoConfig          fwtypes.ListNestedObjectValueOf[cognitoConfigModel]          `tfsdk:"cognito_config"`
+	LambdaAuthorizerConfig fwtypes.ListNestedObjectValueOf[lambdaAuthorizerConfigModel] `tfsdk:"lambda_authorizer_config"`
+	OpenIDConnectConfig    fwtypes.ListNestedObjectValueOf[openIDConnectConfigModel]    `tfsdk:"openid_connect_config"`
+}
+
+type cognitoConfigModel struct {
+	AppIDClientRegex types.String `tfsdk:"app_id_client_regex"`
+	AWSRegion        types.String `tfsdk:"aws_region"`
+	UserPoolID       types.String `tfsdk:"user_pool_id"`
+}
+
+type lambdaAuthorizerConfigModel struct {
+	AuthorizerResultTTLInSeconds types.Int64  `tfsdk:"authorizer_result_ttl_in_seconds"`
+	AuthorizerURI                types.String `tfsdk:"authorizer_uri"`
+	IdentityValidationExpression types.String `tfsdk:"identity_validation_expression"`
+}
+
+type openIDConnectConfigModel struct {
+	AuthTTL  types.Int64  `tfsdk:"auth_ttl"`
+	ClientID types.String `tfsdk:"client_id"`
+	IatTTL   types.Int64  `tfsdk:"iat_ttl"`
+	Issuer   types.String `tfsdk:"issuer"`
+}
+
+type authModeModel struct {
+	AuthType fwtypes.StringEnum[awstypes.AuthenticationType] `tfsdk:"auth_type"`
+}
+
+type eventLogConfigModel struct {
+	CloudWatchLogsRoleARN fwtypes.ARN                                `tfsdk:"cloudwatch_logs_role_arn"`
+	LogLevel              fwtypes.StringEnum[awstypes.EventLogLevel] `tfsdk:"log_level"`
+}
@@ -124,6 +124,35 @@ func testAccAPIKey_expires(t *testing.T) {
 	})
 }
 
+func testAccAPIKey_eventAPI(t *testing.T) {
+	ctx := acctest.Context(t)
+	var apiKey awstypes.ApiKey
+	resourceName := "aws_appsync_api_key.test"
+	rName := sdkacctest.RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyConfig_eventAPI(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIKeyExists(ctx, resourceName, &apiKey),
+					resource.TestCheckResourceAttrPair(resourceName, "api_id", "aws_appsync_api.test", "api_id"),
+					resource.TestMatchResourceAttr(resourceName, names.AttrKey, regexache.MustCompile(`.+`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAPIKeyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncClient(ctx)
@@ -222,3 +251,33 @@ resource "aws_appsync_api_key" "test" {
 }
 `, rName)
 }
+
+func testAccAPIKeyConfig_eventAPI(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_api" "test" {
+  name = %q
+
+  event_config {
+    auth_provider {
+      auth_type = "API_KEY"
+    }
+
+    connection_auth_mode {
+      auth_type = "API_KEY"
+    }
+
+    default_publish_auth_mode {
+      auth_type = "API_KEY"
+    }
+
+    default_subscribe_auth_mode {
+      auth_type = "API_KEY"
+    }
+  }
+}
+
+resource "aws_appsync_api_key" "test" {
+  api_id = aws_appsync_api.test.api_id
+}
+`, rName)
+}
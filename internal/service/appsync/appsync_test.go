@@ -13,11 +13,25 @@ func TestAccAppSync_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"API": {
+			acctest.CtBasic:      testAccAPI_basic,
+			acctest.CtDisappears: testAccAPI_disappears,
+			"authModes":          testAccAPI_authModes,
+			"logConfig":          testAccAPI_logConfig,
+			"tags":               testAccAPI_tags,
+		},
 		"APIKey": {
 			acctest.CtBasic: testAccAPIKey_basic,
 			"description":   testAccAPIKey_description,
+			"eventAPI":      testAccAPIKey_eventAPI,
 			"expires":       testAccAPIKey_expires,
 		},
+		"ChannelNamespace": {
+			acctest.CtBasic:        testAccChannelNamespace_basic,
+			acctest.CtDisappears:   testAccChannelNamespace_disappears,
+			"handlersAndAuthModes": testAccChannelNamespace_handlersAndAuthModes,
+			"tags":                 testAccChannelNamespace_tags,
+		},
 		"DataSource": {
 			acctest.CtBasic:                 testAccDataSource_basic,
 			"description":                   testAccDataSource_description,
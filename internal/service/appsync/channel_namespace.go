@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	autoflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	channelNamespaceIDPartCount = 2
+)
+
+// @FrameworkResource("aws_appsync_channel_namespace", name="Channel Namespace")
+// @Tags(identifierAttribute="arn")
+func newChannelNamespaceResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &channelNamespaceResource{}
+
+	return r, nil
+}
+
+type channelNamespaceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*channelNamespaceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_appsync_channel_namespace"
+}
+
+func (r *channelNamespaceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	authModeBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[authModeModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"auth_type": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.AuthenticationType](),
+						Required:   true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"code_handlers": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32768),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 50),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"publish_auth_mode":   authModeBlock(),
+			"subscribe_auth_mode": authModeBlock(),
+		},
+	}
+}
+
+func (r *channelNamespaceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data channelNamespaceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppSyncClient(ctx)
+
+	name := data.Name.ValueString()
+	var input appsync.CreateChannelNamespaceInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateChannelNamespace(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating AppSync Channel Namespace (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ChannelNamespaceARN = fwflex.StringToFramework(ctx, output.ChannelNamespace.ChannelNamespaceArn)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("creating resource ID", err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *channelNamespaceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data channelNamespaceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().AppSyncClient(ctx)
+
+	output, err := findChannelNamespaceByTwoPartKey(ctx, conn, data.APIID.ValueString(), data.Name.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading AppSync Channel Namespace (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *channelNamespaceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new channelNamespaceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppSyncClient(ctx)
+
+	if !new.CodeHandlers.Equal(old.CodeHandlers) ||
+		!new.PublishAuthModes.Equal(old.PublishAuthModes) ||
+		!new.SubscribeAuthModes.Equal(old.SubscribeAuthModes) {
+		var input appsync.UpdateChannelNamespaceInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateChannelNamespace(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating AppSync Channel Namespace (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *channelNamespaceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data channelNamespaceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppSyncClient(ctx)
+
+	_, err := conn.DeleteChannelNamespace(ctx, &appsync.DeleteChannelNamespaceInput{
+		ApiId: fwflex.StringFromFramework(ctx, data.APIID),
+		Name:  fwflex.StringFromFramework(ctx, data.Name),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting AppSync Channel Namespace (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *channelNamespaceResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findChannelNamespaceByTwoPartKey(ctx context.Context, conn *appsync.Client, apiID, name string) (*awstypes.ChannelNamespace, error) {
+	input := &appsync.GetChannelNamespaceInput{
+		ApiId: aws.String(apiID),
+		Name:  aws.String(name),
+	}
+
+	output, err := conn.GetChannelNamespace(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ChannelNamespace == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ChannelNamespace, nil
+}
+
+type channelNamespaceResourceModel struct {
+	APIID               types.String                                   `tfsdk:"api_id"`
+	ChannelNamespaceARN types.String                                   `tfsdk:"arn"`
+	CodeHandlers        types.String                                   `tfsdk:"code_handlers"`
+	ID                  types.String                                   `tfsdk:"id"`
+	Name                types.String                                   `tfsdk:"name"`
+	PublishAuthModes    fwtypes.ListNestedObjectValueOf[authModeModel] `tfsdk:"publish_auth_mode"`
+	SubscribeAuthModes  fwtypes.ListNestedObjectValueOf[authModeModel] `tfsdk:"subscribe_auth_mode"`
+	Tags                tftags.Map                                     `tfsdk:"tags"`
+	TagsAll             tftags.Map                                     `tfsdk:"tags_all"`
+}
+
+func (m *channelNamespaceResourceModel) InitFromID() error {
+	parts, err := autoflex.ExpandResourceId(m.ID.ValueString(), channelNamespaceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.APIID = types.StringValue(parts[0])
+	m.Name = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *channelNamespaceResourceModel) setID() (string, error) {
+	parts := []string{
+		m.APIID.ValueString(),
+		m.Name.ValueString(),
+	}
+
+	return autoflex.FlattenResourceId(parts, channelNamespaceIDPartCount, false)
+}
@@ -5,8 +5,10 @@ package appsync
 
 // Exports for use in tests only.
 var (
+	ResourceAPI                      = newAPIResource
 	ResourceAPICache                 = resourceAPICache
 	ResourceAPIKey                   = resourceAPIKey
+	ResourceChannelNamespace         = newChannelNamespaceResource
 	ResourceDataSource               = resourceDataSource
 	ResourceDomainName               = resourceDomainName
 	ResourceDomainNameAPIAssociation = resourceDomainNameAPIAssociation
@@ -17,8 +19,10 @@ var (
 	ResourceSourceAPIAssociation     = newSourceAPIAssociationResource
 
 	DefaultAuthorizerResultTTLInSeconds  = defaultAuthorizerResultTTLInSeconds
+	FindAPIByID                          = findAPIByID
 	FindAPICacheByID                     = findAPICacheByID
 	FindAPIKeyByTwoPartKey               = findAPIKeyByTwoPartKey
+	FindChannelNamespaceByTwoPartKey     = findChannelNamespaceByTwoPartKey
 	FindDataSourceByTwoPartKey           = findDataSourceByTwoPartKey
 	FindDomainNameAPIAssociationByID     = findDomainNameAPIAssociationByID
 	FindDomainNameByID                   = findDomainNameByID
@@ -0,0 +1,24 @@
+/**
+ * Copyright (c) HashiCorp, Inc.
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+import { util } from '@aws-appsync/utils';
+
+/**
+ * Drops events without a payload
+ * @param ctx the publish context
+ */
+export function onPublish(ctx) {
+  return ctx.events.filter((event) => event.payload !== undefined);
+}
+
+/**
+ * Rejects subscriptions outside the namespace root
+ * @param ctx the subscribe context
+ */
+export function onSubscribe(ctx) {
+  if (ctx.info.channel.path.includes('private')) {
+    util.unauthorized();
+  }
+}
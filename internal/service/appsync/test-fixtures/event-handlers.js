@@ -0,0 +1,12 @@
+/**
+ * Copyright (c) HashiCorp, Inc.
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+/**
+ * Forwards every published event unchanged
+ * @param ctx the publish context
+ */
+export function onPublish(ctx) {
+  return ctx.events;
+}
@@ -201,7 +201,7 @@ func resourceGroup() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidIAMRoleARN,
 						},
 					},
 				},
@@ -82,7 +82,7 @@ func resourceLifecycleHook() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 		},
 	}
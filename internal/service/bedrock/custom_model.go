@@ -137,6 +137,9 @@ func (r *customModelResource) Schema(ctx context.Context, request resource.Schem
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					fwvalidators.IAMRoleARN(),
+				},
 			},
 			names.AttrTags:       tftags.TagsAttribute(),
 			names.AttrTagsAll:    tftags.TagsAttributeComputedOnly(),
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -84,6 +85,9 @@ func (r *resourceModelInvocationLoggingConfiguration) Schema(ctx context.Context
 							names.AttrRoleARN: schema.StringAttribute{
 								CustomType: fwtypes.ARNType,
 								Optional:   true,
+								Validators: []validator.String{
+									fwvalidators.IAMRoleARN(),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
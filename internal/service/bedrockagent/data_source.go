@@ -237,6 +237,9 @@ func (r *dataSourceResource) Schema(ctx context.Context, request resource.Schema
 									"bucket_arn": schema.StringAttribute{
 										CustomType: fwtypes.ARNType,
 										Required:   true,
+										Validators: []validator.String{
+											fwvalidators.S3BucketARN(),
+										},
 									},
 									"bucket_owner_account_id": schema.StringAttribute{
 										Optional: true,
@@ -192,7 +192,7 @@ func ResourceBudgetAction() *schema.Resource {
 			names.AttrExecutionRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"notification_type": {
 				Type:             schema.TypeString,
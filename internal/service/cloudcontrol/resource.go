@@ -8,13 +8,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
-	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -25,8 +25,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/mattbaird/jsonpatch"
 )
@@ -47,8 +48,9 @@ func resourceResource() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"desired_state": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 			},
 			names.AttrProperties: {
 				Type:     schema.TypeString,
@@ -154,6 +156,22 @@ func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.Set(names.AttrProperties, resourceDescription.Properties)
 
+	// Report drift in the properties that are configured.
+	if desiredState := d.Get("desired_state").(string); desiredState != "" {
+		// The schema is only used to identify write-only properties, which are never returned.
+		typeSchema, _ := parseResourceSchema(d.Get(names.AttrSchema).(string))
+
+		v, err := flattenDesiredState(typeSchema, desiredState, aws.ToString(resourceDescription.Properties))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
+		}
+
+		if !tfjson.EqualStrings(desiredState, v) {
+			d.Set("desired_state", v)
+		}
+	}
+
 	return diags
 }
 
@@ -165,7 +183,13 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	if d.HasChange("desired_state") {
 		oldRaw, newRaw := d.GetChange("desired_state")
 
-		patchDocument, err := patchDocument(oldRaw.(string), newRaw.(string))
+		typeSchema, err := parseResourceSchema(d.Get(names.AttrSchema).(string))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		patchDocument, err := patchDocument(typeSchema, oldRaw.(string), newRaw.(string))
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating JSON Patch: %s", err)
@@ -242,7 +266,8 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+	awsClient := meta.(*conns.AWSClient)
+	conn := awsClient.CloudFormationClient(ctx)
 
 	resourceSchema := diff.Get(names.AttrSchema).(string)
 
@@ -252,13 +277,13 @@ func resourceResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.Re
 
 	typeName := diff.Get("type_name").(string)
 
-	output, err := tfcloudformation.FindTypeByName(ctx, conn, typeName)
+	v, err := findResourceSchemaByTypeName(ctx, conn, awsClient.AccountID(ctx), awsClient.Region(ctx), typeName)
 
 	if err != nil {
 		return fmt.Errorf("reading CloudFormation Type (%s): %w", typeName, err)
 	}
 
-	if err := diff.SetNew(names.AttrSchema, output.Schema); err != nil {
+	if err := diff.SetNew(names.AttrSchema, v); err != nil {
 		return fmt.Errorf("setting schema New: %w", err)
 	}
 
@@ -280,19 +305,22 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return nil
 	}
 
-	newSchema, err := cfschema.Sanitize(newSchema)
+	typeSchema, err := parseResourceSchema(newSchema)
 
 	if err != nil {
-		return fmt.Errorf("sanitizing CloudFormation Resource Schema JSON: %w", err)
+		return err
 	}
 
-	cfResourceSchema, err := cfschema.NewResourceJsonSchemaDocument(newSchema)
+	// schema can be empty if unknown
+	if typeSchema == nil {
+		return nil
+	}
 
-	if err != nil {
-		return fmt.Errorf("parsing CloudFormation Resource Schema JSON: %w", err)
+	if err := typeSchema.document.ValidateConfigurationDocument(newDesiredState); err != nil {
+		return fmt.Errorf("validating desired_state against CloudFormation Resource Schema: %w", err)
 	}
 
-	if err := cfResourceSchema.ValidateConfigurationDocument(newDesiredState); err != nil {
+	if err := typeSchema.validateReadOnlyProperties(newDesiredState); err != nil {
 		return fmt.Errorf("validating desired_state against CloudFormation Resource Schema: %w", err)
 	}
 
@@ -301,12 +329,6 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return nil
 	}
 
-	cfResource, err := cfResourceSchema.Resource()
-
-	if err != nil {
-		return fmt.Errorf("converting CloudFormation Resource Schema JSON: %w", err)
-	}
-
 	patches, err := jsonpatch.CreatePatch([]byte(oldDesiredStateRaw.(string)), []byte(newDesiredStateRaw.(string)))
 
 	if err != nil {
@@ -314,7 +336,7 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 	}
 
 	for _, patch := range patches {
-		if cfResource.IsCreateOnlyPropertyPath(patch.Path) {
+		if typeSchema.isCreateOnlyPropertyPath(patch.Path) {
 			if err := diff.ForceNew("desired_state"); err != nil {
 				return fmt.Errorf("setting desired_state ForceNew: %w", err)
 			}
@@ -430,13 +452,20 @@ func waitProgressEventOperationStatusSuccess(ctx context.Context, conn *cloudcon
 }
 
 // patchDocument returns a JSON Patch document describing the difference between `old` and `new`.
-func patchDocument(old, new string) (string, error) {
+// Operations on read-only properties are omitted.
+func patchDocument(s *resourceSchema, old, new string) (string, error) {
 	patch, err := jsonpatch.CreatePatch([]byte(old), []byte(new))
 
 	if err != nil {
 		return "", err
 	}
 
+	if s != nil {
+		patch = slices.DeleteFunc(patch, func(op jsonpatch.JsonPatchOperation) bool {
+			return s.isReadOnlyPropertyPath(op.Path)
+		})
+	}
+
 	b, err := json.Marshal(patch)
 
 	if err != nil {
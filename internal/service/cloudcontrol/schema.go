@@ -0,0 +1,342 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+)
+
+// resourceSchema is a parsed CloudFormation resource type schema.
+type resourceSchema struct {
+	document *cfschema.ResourceJsonSchema
+	resource *cfschema.Resource
+}
+
+func newResourceSchema(s string) (*resourceSchema, error) {
+	s, err := cfschema.Sanitize(s)
+	if err != nil {
+		return nil, fmt.Errorf("sanitizing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	document, err := cfschema.NewResourceJsonSchemaDocument(s)
+	if err != nil {
+		return nil, fmt.Errorf("parsing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	resource, err := document.Resource()
+	if err != nil {
+		return nil, fmt.Errorf("converting CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	return &resourceSchema{
+		document: document,
+		resource: resource,
+	}, nil
+}
+
+// parsedResourceSchemaCache caches parsed CloudFormation resource type schemas by schema JSON.
+var parsedResourceSchemaCache sync.Map // map[string]*resourceSchema
+
+// parseResourceSchema returns the parsed CloudFormation resource type schema.
+// An empty schema returns nil.
+func parseResourceSchema(s string) (*resourceSchema, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	if v, ok := parsedResourceSchemaCache.Load(s); ok {
+		return v.(*resourceSchema), nil
+	}
+
+	v, err := newResourceSchema(s)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedResourceSchemaCache.Store(s, v)
+
+	return v, nil
+}
+
+// validateReadOnlyProperties returns an error if the JSON document sets any read-only property.
+func (s *resourceSchema) validateReadOnlyProperties(document string) error {
+	v, err := decodeJSON(document)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, ptr := range s.resource.ReadOnlyProperties {
+		if jsonContainsPath(v, ptr.Path()) {
+			errs = append(errs, fmt.Errorf("read-only property (%s) must not be set", ptr.String()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// jsonContainsPath returns whether the decoded JSON value has a value at the schema property path.
+func jsonContainsPath(v any, path []string) bool {
+	if len(path) == 0 {
+		return true
+	}
+
+	switch v := v.(type) {
+	case map[string]any:
+		if v, ok := v[path[0]]; ok {
+			return jsonContainsPath(v, path[1:])
+		}
+	case []any:
+		if path[0] == "*" {
+			return slices.ContainsFunc(v, func(v any) bool {
+				return jsonContainsPath(v, path[1:])
+			})
+		}
+	}
+
+	return false
+}
+
+// isCreateOnlyPropertyPath returns whether a change at the JSON Pointer path affects a create-only property.
+// A change to an ancestor of a create-only property also affects it.
+func (s *resourceSchema) isCreateOnlyPropertyPath(path string) bool {
+	return slices.ContainsFunc(s.resource.CreateOnlyProperties, func(ptr cfschema.PropertyJsonPointer) bool {
+		return propertyPathContains(ptr, path) || propertyPathContainedBy(ptr, path)
+	})
+}
+
+// isReadOnlyPropertyPath returns whether the JSON Pointer path is, or is within, a read-only property.
+func (s *resourceSchema) isReadOnlyPropertyPath(path string) bool {
+	return slices.ContainsFunc(s.resource.ReadOnlyProperties, func(ptr cfschema.PropertyJsonPointer) bool {
+		return propertyPathContains(ptr, path)
+	})
+}
+
+// isWriteOnlyPropertyPath returns whether the JSON Pointer path is, or is within, a write-only property.
+func (s *resourceSchema) isWriteOnlyPropertyPath(path string) bool {
+	return slices.ContainsFunc(s.resource.WriteOnlyProperties, func(ptr cfschema.PropertyJsonPointer) bool {
+		return propertyPathContains(ptr, path)
+	})
+}
+
+// propertyPathContains returns whether the JSON Pointer path is, or is within, the schema property.
+// Schema property pointers use "*" to match any array index.
+func propertyPathContains(ptr cfschema.PropertyJsonPointer, path string) bool {
+	want, got := ptr.Path(), jsonPointerPath(path)
+
+	if len(got) < len(want) {
+		return false
+	}
+
+	for i, segment := range want {
+		if segment != "*" && segment != got[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// propertyPathContainedBy returns whether the schema property is within the JSON Pointer path.
+func propertyPathContainedBy(ptr cfschema.PropertyJsonPointer, path string) bool {
+	want, got := ptr.Path(), jsonPointerPath(path)
+
+	if len(got) >= len(want) {
+		return false
+	}
+
+	for i, segment := range got {
+		if want[i] != "*" && want[i] != segment {
+			return false
+		}
+	}
+
+	return true
+}
+
+// jsonPointerPath returns the unescaped reference tokens of a JSON Pointer (RFC 6901).
+func jsonPointerPath(ptr string) []string {
+	if ptr == "" {
+		return nil
+	}
+
+	path := strings.Split(strings.TrimPrefix(ptr, "/"), "/")
+	for i, v := range path {
+		path[i] = strings.ReplaceAll(strings.ReplaceAll(v, "~1", "/"), "~0", "~")
+	}
+
+	return path
+}
+
+// jsonPointer returns the JSON Pointer (RFC 6901) for the reference tokens.
+func jsonPointer(path []string) string {
+	var sb strings.Builder
+
+	for _, v := range path {
+		sb.WriteString("/")
+		sb.WriteString(strings.ReplaceAll(strings.ReplaceAll(v, "~", "~0"), "/", "~1"))
+	}
+
+	return sb.String()
+}
+
+// resourceSchemaCache caches CloudFormation resource type schemas.
+// A plan may include many resources of the same type and the schema registry is throttled.
+// Private and third-party registry types, and the default versions of public types, differ by account and Region.
+var resourceSchemaCache sync.Map // map[resourceSchemaCacheKey]string
+
+type resourceSchemaCacheKey struct {
+	accountID string
+	region    string
+	typeName  string
+}
+
+func findResourceSchemaByTypeName(ctx context.Context, conn *cloudformation.Client, accountID, region, typeName string) (string, error) {
+	key := resourceSchemaCacheKey{accountID: accountID, region: region, typeName: typeName}
+
+	if v, ok := resourceSchemaCache.Load(key); ok {
+		return v.(string), nil
+	}
+
+	output, err := tfcloudformation.FindTypeByName(ctx, conn, typeName)
+
+	if err != nil {
+		return "", err
+	}
+
+	schema := aws.ToString(output.Schema)
+	resourceSchemaCache.Store(key, schema)
+
+	return schema, nil
+}
+
+// flattenDesiredState returns the desired state updated with the values of the resource's current properties.
+// Only properties present in the desired state are considered, so that properties set by AWS are not reported as drift.
+// Write-only properties are never returned by AWS and keep their desired values.
+// The returned JSON is only meaningful when compared with the desired state for semantic equality.
+func flattenDesiredState(s *resourceSchema, desiredState, properties string) (string, error) {
+	desired, err := decodeJSON(desiredState)
+	if err != nil {
+		return "", fmt.Errorf("decoding desired_state: %w", err)
+	}
+
+	actual, err := decodeJSON(properties)
+	if err != nil {
+		return "", fmt.Errorf("decoding properties: %w", err)
+	}
+
+	b, err := json.Marshal(mergeDesiredState(s, nil, desired, actual))
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func mergeDesiredState(s *resourceSchema, path []string, desired, actual any) any {
+	if s != nil && s.isWriteOnlyPropertyPath(jsonPointer(path)) {
+		return desired
+	}
+
+	switch desired := desired.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return actual
+		}
+
+		merged := make(map[string]any, len(desired))
+		for k, v := range desired {
+			if v2, ok := a[k]; ok {
+				merged[k] = mergeDesiredState(s, append(slices.Clone(path), k), v, v2)
+			} else {
+				// Write-only properties are not returned and AWS may omit values equal to their defaults.
+				merged[k] = v
+			}
+		}
+
+		return merged
+
+	case []any:
+		a, ok := actual.([]any)
+		if !ok {
+			return actual
+		}
+
+		if len(desired) != len(a) {
+			return actual
+		}
+
+		merged := make([]any, len(desired))
+		for i := range desired {
+			merged[i] = mergeDesiredState(s, append(slices.Clone(path), "*"), desired[i], a[i])
+		}
+
+		// Many services do not preserve the order of lists.
+		if isPermutation(desired, merged) {
+			return desired
+		}
+
+		return merged
+
+	default:
+		// Scalar values may be returned with a different JSON type, e.g. "80" for 80.
+		if fmt.Sprint(desired) == fmt.Sprint(actual) {
+			return desired
+		}
+
+		return actual
+	}
+}
+
+func isPermutation(a, b []any) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[string]int, len(a))
+	for _, v := range a {
+		counts[canonicalJSON(v)]++
+	}
+	for _, v := range b {
+		k := canonicalJSON(v)
+		if counts[k] == 0 {
+			return false
+		}
+		counts[k]--
+	}
+
+	return true
+}
+
+func canonicalJSON(v any) string {
+	b, _ := json.Marshal(v)
+
+	return string(b)
+}
+
+func decodeJSON(s string) (any, error) {
+	var v any
+
+	dec := json.NewDecoder(bytes.NewReader([]byte(s)))
+	dec.UseNumber()
+
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"testing"
+
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+const testResourceSchema = `{
+  "typeName": "Example::Test::Resource",
+  "description": "Example resource",
+  "properties": {
+    "Name": {"type": "string"},
+    "Arn": {"type": "string"},
+    "Password": {"type": "string"},
+    "Port": {"type": "integer"},
+    "Settings": {
+      "type": "object",
+      "properties": {
+        "Mode": {"type": "string"},
+        "Token": {"type": "string"}
+      }
+    },
+    "Tags": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "Key": {"type": "string"},
+          "Value": {"type": "string"}
+        }
+      }
+    }
+  },
+  "additionalProperties": false,
+  "primaryIdentifier": ["/properties/Name"],
+  "readOnlyProperties": ["/properties/Arn"],
+  "writeOnlyProperties": ["/properties/Password", "/properties/Settings/Token"],
+  "createOnlyProperties": ["/properties/Name", "/properties/Settings/Mode"]
+}`
+
+func testParseResourceSchema(t *testing.T) *resourceSchema {
+	t.Helper()
+
+	s, err := parseResourceSchema(testResourceSchema)
+	if err != nil {
+		t.Fatalf("parsing schema: %s", err)
+	}
+
+	return s
+}
+
+func TestParseResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	s, err := parseResourceSchema("")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if s != nil {
+		t.Errorf("expected nil schema")
+	}
+
+	s1, s2 := testParseResourceSchema(t), testParseResourceSchema(t)
+	if s1 != s2 {
+		t.Errorf("expected cached schema")
+	}
+
+	if _, err := parseResourceSchema("{"); err == nil {
+		t.Errorf("expected error")
+	}
+}
+
+func TestPropertyPathContains(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		ptr      cfschema.PropertyJsonPointer
+		path     string
+		expected bool
+	}{
+		{"/properties/Name", "/Name", true},
+		{"/properties/Name", "/NameSuffix", false},
+		{"/properties/Settings", "/Settings/Mode", true},
+		{"/properties/Settings/Mode", "/Settings", false},
+		{"/properties/Tags/*/Key", "/Tags/0/Key", true},
+		{"/properties/Tags/*/Key", "/Tags/1/Value", false},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := propertyPathContains(testCase.ptr, testCase.path), testCase.expected; got != want {
+			t.Errorf("propertyPathContains(%q, %q) = %t, want %t", testCase.ptr, testCase.path, got, want)
+		}
+	}
+}
+
+func TestResourceSchemaIsCreateOnlyPropertyPath(t *testing.T) {
+	t.Parallel()
+
+	s := testParseResourceSchema(t)
+
+	testCases := []struct {
+		path     string
+		expected bool
+	}{
+		{"/Name", true},
+		{"/Settings/Mode", true},
+		{"/Settings", true},
+		{"/Settings/Token", false},
+		{"/Port", false},
+		{"/Tags/0", false},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := s.isCreateOnlyPropertyPath(testCase.path), testCase.expected; got != want {
+			t.Errorf("isCreateOnlyPropertyPath(%q) = %t, want %t", testCase.path, got, want)
+		}
+	}
+}
+
+func TestResourceSchemaValidateReadOnlyProperties(t *testing.T) {
+	t.Parallel()
+
+	s := testParseResourceSchema(t)
+
+	if err := s.validateReadOnlyProperties(`{"Name":"test"}`); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if err := s.validateReadOnlyProperties(`{"Name":"test","Arn":"arn"}`); err == nil {
+		t.Errorf("expected error")
+	}
+}
+
+func TestJSONPointer(t *testing.T) {
+	t.Parallel()
+
+	for _, path := range [][]string{nil, {"a"}, {"a/b", "c~d"}} {
+		ptr := jsonPointer(path)
+		if got, want := len(jsonPointerPath(ptr)), len(path); got != want {
+			t.Errorf("jsonPointerPath(%q) has %d tokens, want %d", ptr, got, want)
+		}
+	}
+
+	if got, want := jsonPointer([]string{"a/b", "c~d"}), "/a~1b/c~0d"; got != want {
+		t.Errorf("jsonPointer = %q, want %q", got, want)
+	}
+}
+
+func TestFlattenDesiredState(t *testing.T) {
+	t.Parallel()
+
+	s := testParseResourceSchema(t)
+
+	testCases := map[string]struct {
+		desiredState string
+		properties   string
+		expected     string
+	}{
+		"unchanged": {
+			desiredState: `{"Name":"test","Port":80}`,
+			properties:   `{"Name":"test","Port":80,"Arn":"arn"}`,
+			expected:     `{"Name":"test","Port":80}`,
+		},
+		"drift": {
+			desiredState: `{"Name":"test","Port":80}`,
+			properties:   `{"Name":"test","Port":8080}`,
+			expected:     `{"Name":"test","Port":8080}`,
+		},
+		"omitted property": {
+			desiredState: `{"Name":"test","Port":80}`,
+			properties:   `{"Name":"test"}`,
+			expected:     `{"Name":"test","Port":80}`,
+		},
+		"write-only properties": {
+			desiredState: `{"Name":"test","Password":"secret","Settings":{"Mode":"a","Token":"t"}}`,
+			properties:   `{"Name":"test","Password":"","Settings":{"Mode":"a","Token":"other"}}`,
+			expected:     `{"Name":"test","Password":"secret","Settings":{"Mode":"a","Token":"t"}}`,
+		},
+		"scalar type": {
+			desiredState: `{"Name":"test","Port":"80"}`,
+			properties:   `{"Name":"test","Port":80}`,
+			expected:     `{"Name":"test","Port":"80"}`,
+		},
+		"reordered array": {
+			desiredState: `{"Tags":[{"Key":"a","Value":"1"},{"Key":"b","Value":"2"}]}`,
+			properties:   `{"Tags":[{"Key":"b","Value":"2"},{"Key":"a","Value":"1"}]}`,
+			expected:     `{"Tags":[{"Key":"a","Value":"1"},{"Key":"b","Value":"2"}]}`,
+		},
+		"array drift": {
+			desiredState: `{"Tags":[{"Key":"a","Value":"1"}]}`,
+			properties:   `{"Tags":[{"Key":"a","Value":"1"},{"Key":"b","Value":"2"}]}`,
+			expected:     `{"Tags":[{"Key":"a","Value":"1"},{"Key":"b","Value":"2"}]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := flattenDesiredState(s, testCase.desiredState, testCase.properties)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !tfjson.EqualStrings(got, testCase.expected) {
+				t.Errorf("got %s, want %s", got, testCase.expected)
+			}
+		})
+	}
+}
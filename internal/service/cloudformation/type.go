@@ -59,7 +59,7 @@ func resourceType() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"is_default_version": {
 				Type:     schema.TypeBool,
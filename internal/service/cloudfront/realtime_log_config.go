@@ -58,7 +58,7 @@ func resourceRealtimeLogConfig() *schema.Resource {
 									names.AttrRoleARN: {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidIAMRoleARN,
 									},
 									names.AttrStreamARN: {
 										Type:         schema.TypeString,
@@ -236,7 +236,7 @@ func resourceTrail() *schema.Resource {
 			names.AttrKMSKeyID: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			names.AttrName: {
 				Type:         schema.TypeString,
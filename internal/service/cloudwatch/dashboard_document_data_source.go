@@ -0,0 +1,806 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Dashboards are laid out on a grid that is 24 units wide.
+	dashboardGridWidth = 24
+
+	dashboardDocDefaultWidgetHeight = 6
+	dashboardDocDefaultWidgetWidth  = 6
+)
+
+// @SDKDataSource("aws_cloudwatch_dashboard_document", name="Dashboard Document")
+func dataSourceDashboardDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDashboardDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"end": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrJSON: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_override": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[dashboardDocPeriodOverride](),
+			},
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"widget": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"alarms": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"sort_by": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"default", "stateUpdatedAt", "timestamp"}, false),
+									},
+									"states": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{"ALARM", "INSUFFICIENT_DATA", "OK"}, false),
+										},
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"explorer": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"label": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrKey: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												names.AttrValue: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"metric": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrMetricName: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												names.AttrResourceType: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"stat": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"split_by": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          string(dashboardDocExplorerViewTimeSeries),
+										ValidateDiagFunc: enum.Validate[dashboardDocExplorerView](),
+									},
+								},
+							},
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      dashboardDocDefaultWidgetHeight,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						"log_query": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group_names": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 50,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"query": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									names.AttrRegion: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          string(dashboardDocLogViewTable),
+										ValidateDiagFunc: enum.Validate[dashboardDocLogView](),
+									},
+								},
+							},
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_query": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"color": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringMatch(regexache.MustCompile(`^#[0-9A-Fa-f]{6}$`), "must be a hex color code such as #1f77b4"),
+												},
+												"dimensions": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												names.AttrExpression: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												names.AttrID: {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[a-z][0-9A-Za-z_]*$`), "must start with a lowercase letter and contain only letters, numbers and underscores"),
+												},
+												"label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												names.AttrMetricName: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												names.AttrNamespace: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"period": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												names.AttrRegion: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"stat": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"visible": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  true,
+												},
+												"y_axis": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ValidateDiagFunc: enum.Validate[dashboardDocYAxis](),
+												},
+											},
+										},
+									},
+									"period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									names.AttrRegion: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          string(dashboardDocMetricViewTimeSeries),
+										ValidateDiagFunc: enum.Validate[dashboardDocMetricView](),
+									},
+								},
+							},
+						},
+						"text": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"background": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[dashboardDocTextBackground](),
+									},
+									"markdown": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      dashboardDocDefaultWidgetWidth,
+							ValidateFunc: validation.IntBetween(1, dashboardGridWidth),
+						},
+						"x": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, dashboardGridWidth-1),
+						},
+						"y": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDashboardDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	dashboardDoc := &cloudWatchDashboardDoc{
+		Widgets: []*dashboardDocWidget{},
+	}
+
+	if v, ok := d.GetOk("end"); ok {
+		dashboardDoc.End = v.(string)
+	}
+	if v, ok := d.GetOk("period_override"); ok {
+		dashboardDoc.PeriodOverride = v.(string)
+	}
+	if v, ok := d.GetOk("start"); ok {
+		dashboardDoc.Start = v.(string)
+	}
+
+	region := meta.(*conns.AWSClient).Region(ctx)
+	var positioned []bool
+
+	for i, tfMapRaw := range d.Get("widget").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			return sdkdiag.AppendErrorf(diags, "widget %d: configuration block is empty", i)
+		}
+
+		widget, err := expandDashboardDocWidget(tfMap, region)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "widget %d: %s", i, err)
+		}
+
+		hasX, hasY := dashboardDocWidgetAttrConfigured(d, i, "x"), dashboardDocWidgetAttrConfigured(d, i, "y")
+		if hasX != hasY {
+			return sdkdiag.AppendErrorf(diags, "widget %d: \"x\" and \"y\" must be configured together", i)
+		}
+		if hasX {
+			widget.X, widget.Y = tfMap["x"].(int), tfMap["y"].(int)
+
+			if widget.X+widget.Width > dashboardGridWidth {
+				return sdkdiag.AppendErrorf(diags, "widget %d: x (%d) plus width (%d) exceeds the dashboard grid width of %d", i, widget.X, widget.Width, dashboardGridWidth)
+			}
+		}
+
+		dashboardDoc.Widgets = append(dashboardDoc.Widgets, widget)
+		positioned = append(positioned, hasX)
+	}
+
+	layoutDashboardDocWidgets(dashboardDoc.Widgets, positioned)
+
+	jsonDoc, err := json.Marshal(dashboardDoc)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	jsonString := string(jsonDoc)
+
+	d.Set(names.AttrJSON, jsonString)
+
+	d.SetId(strconv.Itoa(schema.HashString(jsonString)))
+
+	return diags
+}
+
+// dashboardDocWidgetAttrConfigured reports whether the named attribute of the i'th widget is set in configuration.
+// The zero value is a valid grid coordinate, so the raw configuration is used to distinguish it from an unset value.
+func dashboardDocWidgetAttrConfigured(d *schema.ResourceData, i int, name string) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.Type().IsObjectType() {
+		return false
+	}
+
+	widgets := rawConfig.GetAttr("widget")
+	if !widgets.IsKnown() || widgets.IsNull() || widgets.LengthInt() <= i {
+		return false
+	}
+
+	v := widgets.Index(cty.NumberIntVal(int64(i))).GetAttr(name)
+
+	return v.IsKnown() && !v.IsNull()
+}
+
+// layoutDashboardDocWidgets assigns grid positions to widgets that were not explicitly positioned.
+// Such widgets flow left to right, wrapping at the grid width, in rows placed below all explicitly positioned widgets.
+func layoutDashboardDocWidgets(widgets []*dashboardDocWidget, positioned []bool) {
+	var x, y, rowHeight int
+
+	for i, widget := range widgets {
+		if positioned[i] {
+			y = max(y, widget.Y+widget.Height)
+		}
+	}
+
+	for i, widget := range widgets {
+		if positioned[i] {
+			continue
+		}
+
+		if x+widget.Width > dashboardGridWidth {
+			x, y, rowHeight = 0, y+rowHeight, 0
+		}
+
+		widget.X, widget.Y = x, y
+		x += widget.Width
+		rowHeight = max(rowHeight, widget.Height)
+	}
+}
+
+func expandDashboardDocWidget(tfMap map[string]interface{}, region string) (*dashboardDocWidget, error) {
+	apiObject := &dashboardDocWidget{
+		Height: tfMap["height"].(int),
+		Width:  tfMap["width"].(int),
+	}
+
+	var n int
+
+	if v, ok := tfMap["alarm"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Type = dashboardDocWidgetTypeAlarm
+		apiObject.Properties = expandDashboardDocAlarmProperties(v[0].(map[string]interface{}))
+		n++
+	}
+	if v, ok := tfMap["explorer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Type = dashboardDocWidgetTypeExplorer
+		apiObject.Properties = expandDashboardDocExplorerProperties(v[0].(map[string]interface{}))
+		n++
+	}
+	if v, ok := tfMap["log_query"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Type = dashboardDocWidgetTypeLog
+		apiObject.Properties = expandDashboardDocLogProperties(v[0].(map[string]interface{}), region)
+		n++
+	}
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		properties, err := expandDashboardDocMetricProperties(v[0].(map[string]interface{}), region)
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject.Type = dashboardDocWidgetTypeMetric
+		apiObject.Properties = properties
+		n++
+	}
+	if v, ok := tfMap["text"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Type = dashboardDocWidgetTypeText
+		apiObject.Properties = expandDashboardDocTextProperties(v[0].(map[string]interface{}))
+		n++
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf(`exactly one of "alarm", "explorer", "log_query", "metric" or "text" must be configured`)
+	}
+
+	return apiObject, nil
+}
+
+func expandDashboardDocAlarmProperties(tfMap map[string]interface{}) *dashboardDocAlarmProperties {
+	apiObject := &dashboardDocAlarmProperties{
+		Alarms: flex.ExpandStringValueList(tfMap["alarms"].([]interface{})),
+	}
+
+	if v, ok := tfMap["sort_by"].(string); ok && v != "" {
+		apiObject.SortBy = v
+	}
+	if v, ok := tfMap["states"].([]interface{}); ok && len(v) > 0 {
+		apiObject.States = flex.ExpandStringValueList(v)
+	}
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject.Title = v
+	}
+
+	return apiObject
+}
+
+func expandDashboardDocExplorerProperties(tfMap map[string]interface{}) *dashboardDocExplorerProperties {
+	apiObject := &dashboardDocExplorerProperties{
+		WidgetOptions: &dashboardDocExplorerOptions{
+			View: tfMap["view"].(string),
+		},
+	}
+
+	for _, tfMapRaw := range tfMap["label"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject.Labels = append(apiObject.Labels, &dashboardDocExplorerLabel{
+			Key:   tfMap[names.AttrKey].(string),
+			Value: tfMap[names.AttrValue].(string),
+		})
+	}
+	for _, tfMapRaw := range tfMap["metric"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject.Metrics = append(apiObject.Metrics, &dashboardDocExplorerMetric{
+			MetricName:   tfMap[names.AttrMetricName].(string),
+			ResourceType: tfMap[names.AttrResourceType].(string),
+			Stat:         tfMap["stat"].(string),
+		})
+	}
+	if v, ok := tfMap["period"].(int); ok && v != 0 {
+		apiObject.Period = v
+	}
+	if v, ok := tfMap["split_by"].(string); ok && v != "" {
+		apiObject.SplitBy = v
+	}
+	if v, ok := tfMap["stacked"].(bool); ok && v {
+		apiObject.WidgetOptions.Stacked = &v
+	}
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject.Title = v
+	}
+
+	return apiObject
+}
+
+func expandDashboardDocLogProperties(tfMap map[string]interface{}, region string) *dashboardDocLogProperties {
+	apiObject := &dashboardDocLogProperties{
+		Region: region,
+		View:   tfMap["view"].(string),
+	}
+
+	// Log Insights widgets encode their log groups as SOURCE commands prefixed to the query.
+	var query []string
+	for _, v := range flex.ExpandStringValueList(tfMap["log_group_names"].([]interface{})) {
+		query = append(query, fmt.Sprintf("SOURCE '%s'", v))
+	}
+	query = append(query, strings.TrimSpace(tfMap["query"].(string)))
+	apiObject.Query = strings.Join(query, " | ")
+
+	if v, ok := tfMap[names.AttrRegion].(string); ok && v != "" {
+		apiObject.Region = v
+	}
+	if v, ok := tfMap["stacked"].(bool); ok && v {
+		apiObject.Stacked = &v
+	}
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject.Title = v
+	}
+
+	return apiObject
+}
+
+func expandDashboardDocMetricProperties(tfMap map[string]interface{}, region string) (*dashboardDocMetricProperties, error) {
+	apiObject := &dashboardDocMetricProperties{
+		Metrics: [][]interface{}{},
+		Region:  region,
+		View:    tfMap["view"].(string),
+	}
+
+	if v, ok := tfMap["period"].(int); ok && v != 0 {
+		apiObject.Period = v
+	}
+	if v, ok := tfMap[names.AttrRegion].(string); ok && v != "" {
+		apiObject.Region = v
+	}
+	if v, ok := tfMap["stacked"].(bool); ok && v {
+		apiObject.Stacked = &v
+	}
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		apiObject.Stat = v
+	}
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject.Title = v
+	}
+
+	tfList := tfMap["metric_query"].([]interface{})
+
+	ids := make(map[string]struct{})
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if id := tfMap[names.AttrID].(string); id != "" {
+			if _, ok := ids[id]; ok {
+				return nil, fmt.Errorf("metric_query id %q is not unique", id)
+			}
+			ids[id] = struct{}{}
+		}
+	}
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		metric, err := expandDashboardDocMetricQuery(tfMap, ids)
+		if err != nil {
+			return nil, fmt.Errorf("metric_query %d: %w", i, err)
+		}
+
+		apiObject.Metrics = append(apiObject.Metrics, metric)
+	}
+
+	return apiObject, nil
+}
+
+// expandDashboardDocMetricQuery returns an entry of a metric widget's "metrics" array.
+// Metrics are encoded as [namespace, metric name, dimension name, dimension value, ..., {options}]
+// and metric math expressions as [{options}].
+func expandDashboardDocMetricQuery(tfMap map[string]interface{}, ids map[string]struct{}) ([]interface{}, error) {
+	options := dashboardDocMetricOptions{}
+
+	if v, ok := tfMap["color"].(string); ok && v != "" {
+		options.Color = v
+	}
+	if v, ok := tfMap[names.AttrID].(string); ok && v != "" {
+		options.ID = v
+	}
+	if v, ok := tfMap["label"].(string); ok && v != "" {
+		options.Label = v
+	}
+	if v, ok := tfMap["period"].(int); ok && v != 0 {
+		options.Period = v
+	}
+	if v, ok := tfMap[names.AttrRegion].(string); ok && v != "" {
+		options.Region = v
+	}
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		options.Stat = v
+	}
+	if v, ok := tfMap["visible"].(bool); ok && !v {
+		options.Visible = &v
+	}
+	if v, ok := tfMap["y_axis"].(string); ok && v != "" {
+		options.YAxis = v
+	}
+
+	expression := tfMap[names.AttrExpression].(string)
+	namespace := tfMap[names.AttrNamespace].(string)
+	metricName := tfMap[names.AttrMetricName].(string)
+	dimensions := tfMap["dimensions"].(map[string]interface{})
+
+	if expression != "" {
+		if namespace != "" || metricName != "" || len(dimensions) > 0 {
+			return nil, fmt.Errorf(`"expression" cannot be combined with "namespace", "metric_name" or "dimensions"`)
+		}
+		if err := validateMetricMathExpression(expression, options.ID, ids); err != nil {
+			return nil, err
+		}
+
+		options.Expression = expression
+
+		return []interface{}{options}, nil
+	}
+
+	if namespace == "" || metricName == "" {
+		return nil, fmt.Errorf(`either "expression" or both "namespace" and "metric_name" must be configured`)
+	}
+
+	metric := []interface{}{namespace, metricName}
+
+	// Sort dimensions by name so that the generated JSON is canonical.
+	keys := make([]string, 0, len(dimensions))
+	for k := range dimensions {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		metric = append(metric, k, dimensions[k].(string))
+	}
+
+	if options != (dashboardDocMetricOptions{}) {
+		metric = append(metric, options)
+	}
+
+	return metric, nil
+}
+
+// validateMetricMathExpression performs offline checks of a metric math expression:
+// parentheses and string literals must be balanced and every metric or expression
+// identifier it references must be the ID of another query in the same widget.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/using-metric-math.html.
+func validateMetricMathExpression(expression, id string, ids map[string]struct{}) error {
+	if strings.TrimSpace(expression) == "" {
+		return fmt.Errorf("metric math expression must not be empty")
+	}
+
+	// Metrics Insights queries use SQL syntax in which lowercase identifiers are metric names, not query IDs.
+	insights := strings.HasPrefix(strings.ToUpper(strings.TrimSpace(expression)), "SELECT ")
+
+	runes := []rune(expression)
+	depth := 0
+
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; {
+		case r == '\'' || r == '"':
+			j := i + 1
+			for j < len(runes) && runes[j] != r {
+				j++
+			}
+			if j == len(runes) {
+				return fmt.Errorf("metric math expression %q has an unterminated string literal", expression)
+			}
+			i = j
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("metric math expression %q has an unmatched closing parenthesis", expression)
+			}
+		case unicode.IsDigit(r):
+			for i+1 < len(runes) && (unicode.IsLetter(runes[i+1]) || unicode.IsDigit(runes[i+1]) || runes[i+1] == '.') {
+				i++
+			}
+		case unicode.IsLetter(r) || r == '_':
+			j := i
+			for j+1 < len(runes) && (unicode.IsLetter(runes[j+1]) || unicode.IsDigit(runes[j+1]) || runes[j+1] == '_') {
+				j++
+			}
+			token := string(runes[i : j+1])
+			i = j
+
+			// Function names and keywords are uppercase, query IDs must start with a lowercase letter.
+			if insights || !unicode.IsLower(r) {
+				continue
+			}
+			if token == id {
+				return fmt.Errorf("metric math expression %q references its own ID", expression)
+			}
+			if _, ok := ids[token]; !ok {
+				return fmt.Errorf("metric math expression %q references unknown ID %q", expression, token)
+			}
+		}
+	}
+
+	if depth != 0 {
+		return fmt.Errorf("metric math expression %q has an unmatched opening parenthesis", expression)
+	}
+
+	return nil
+}
+
+func expandDashboardDocTextProperties(tfMap map[string]interface{}) *dashboardDocTextProperties {
+	apiObject := &dashboardDocTextProperties{
+		Markdown: tfMap["markdown"].(string),
+	}
+
+	if v, ok := tfMap["background"].(string); ok && v != "" {
+		apiObject.Background = v
+	}
+
+	return apiObject
+}
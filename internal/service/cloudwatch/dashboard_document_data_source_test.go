@@ -0,0 +1,431 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcloudwatch "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestLayoutDashboardDocWidgets(t *testing.T) {
+	t.Parallel()
+
+	widgets := []*tfcloudwatch.DashboardDocWidget{
+		{X: 0, Y: 0, Width: 24, Height: 2},
+		{Width: 12, Height: 6},
+		{Width: 12, Height: 3},
+		{Width: 8, Height: 6},
+		{X: 12, Y: 20, Width: 6, Height: 6},
+	}
+	positioned := []bool{true, false, false, false, true}
+
+	tfcloudwatch.LayoutDashboardDocWidgets(widgets, positioned)
+
+	expected := [][2]int{
+		{0, 0},
+		{0, 26},
+		{12, 26},
+		{0, 32},
+		{12, 20},
+	}
+	for i, widget := range widgets {
+		if got, want := [2]int{widget.X, widget.Y}, expected[i]; got != want {
+			t.Errorf("widget %d: got position %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestValidateMetricMathExpression(t *testing.T) {
+	t.Parallel()
+
+	ids := map[string]struct{}{
+		"m1": {},
+		"m2": {},
+		"e1": {},
+	}
+
+	testCases := []struct {
+		expression string
+		id         string
+		valid      bool
+	}{
+		{expression: "m1 + m2", id: "e1", valid: true},
+		{expression: "SUM(METRICS())", id: "e1", valid: true},
+		{expression: "100 * (m1 / m2)", valid: true},
+		{expression: "FILL(m1, 0) * 1e3", id: "e1", valid: true},
+		{expression: `SEARCH('{AWS/EC2,InstanceId} MetricName="CPUUtilization"', 'Average', 300)`, valid: true},
+		{expression: `SELECT AVG(cpu_usage) FROM SCHEMA("Custom", host)`, valid: true},
+		{expression: "", valid: false},
+		{expression: "m1 + m3", valid: false},
+		{expression: "e1 * 2", id: "e1", valid: false},
+		{expression: "SUM(m1, m2", valid: false},
+		{expression: "m1)", valid: false},
+		{expression: "SEARCH('unterminated)", valid: false},
+	}
+
+	for _, testCase := range testCases {
+		err := tfcloudwatch.ValidateMetricMathExpression(testCase.expression, testCase.id, ids)
+
+		if got, want := err == nil, testCase.valid; got != want {
+			t.Errorf("ValidateMetricMathExpression(%q) valid = %t, want %t: %v", testCase.expression, got, want, err)
+		}
+	}
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccDashboardDocumentExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_complete(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+	resourceName := "aws_cloudwatch_dashboard.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_complete(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccDashboardDocumentExpectedJSON_complete(acctest.Region())),
+					resource.TestCheckResourceAttrPair(resourceName, "dashboard_body", dataSourceName, names.AttrJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_alarm(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_alarm,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccDashboardDocumentExpectedJSON_alarm),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_invalidExpression(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_invalidExpression,
+				ExpectError: regexache.MustCompile(`references unknown ID "m2"`),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_multipleWidgetTypes(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_multipleWidgetTypes,
+				ExpectError: regexache.MustCompile(`exactly one of "alarm", "explorer", "log_query", "metric" or "text" must be configured`),
+			},
+		},
+	})
+}
+
+const testAccDashboardDocumentDataSourceConfig_basic = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    text {
+      markdown = "Hello world"
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentExpectedJSON_basic = `{
+  "widgets": [
+    {
+      "type": "text",
+      "x": 0,
+      "y": 0,
+      "width": 6,
+      "height": 6,
+      "properties": {
+        "markdown": "Hello world"
+      }
+    }
+  ]
+}`
+
+func testAccDashboardDocumentDataSourceConfig_complete(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  start           = "-PT6H"
+  period_override = "inherit"
+
+  widget {
+    width  = 24
+    height = 2
+
+    text {
+      markdown   = "# Service health"
+      background = "transparent"
+    }
+  }
+
+  widget {
+    width = 12
+
+    metric {
+      title   = "Error rate"
+      stat    = "Sum"
+      period  = 300
+      stacked = true
+
+      metric_query {
+        id          = "m1"
+        namespace   = "AWS/ApplicationELB"
+        metric_name = "HTTPCode_Target_5XX_Count"
+        dimensions = {
+          LoadBalancer = "app/example/1234567890abcdef"
+        }
+        visible = false
+      }
+
+      metric_query {
+        id          = "m2"
+        namespace   = "AWS/ApplicationELB"
+        metric_name = "RequestCount"
+        dimensions = {
+          LoadBalancer = "app/example/1234567890abcdef"
+        }
+        visible = false
+      }
+
+      metric_query {
+        id         = "e1"
+        expression = "100 * (m1 / m2)"
+        label      = "Error rate (%%)"
+        color      = "#d62728"
+      }
+    }
+  }
+
+  widget {
+    width = 12
+
+    log_query {
+      title           = "Recent errors"
+      log_group_names = ["/aws/lambda/example"]
+      query           = "fields @timestamp, @message | filter @message like /ERROR/ | limit 20"
+    }
+  }
+
+  widget {
+    width = 24
+
+    explorer {
+      title    = "EC2 by availability zone"
+      period   = 300
+      split_by = "AvailabilityZone"
+
+      metric {
+        metric_name   = "CPUUtilization"
+        resource_type = "AWS::EC2::Instance"
+        stat          = "Average"
+      }
+
+      label {
+        key   = "Environment"
+        value = "production"
+      }
+    }
+  }
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  dashboard_body = data.aws_cloudwatch_dashboard_document.test.json
+}
+`, rName)
+}
+
+func testAccDashboardDocumentExpectedJSON_complete(region string) string {
+	return fmt.Sprintf(`{
+  "start": "-PT6H",
+  "periodOverride": "inherit",
+  "widgets": [
+    {
+      "type": "text",
+      "x": 0,
+      "y": 0,
+      "width": 24,
+      "height": 2,
+      "properties": {
+        "background": "transparent",
+        "markdown": "# Service health"
+      }
+    },
+    {
+      "type": "metric",
+      "x": 0,
+      "y": 2,
+      "width": 12,
+      "height": 6,
+      "properties": {
+        "metrics": [
+          ["AWS/ApplicationELB", "HTTPCode_Target_5XX_Count", "LoadBalancer", "app/example/1234567890abcdef", {"id": "m1", "visible": false}],
+          ["AWS/ApplicationELB", "RequestCount", "LoadBalancer", "app/example/1234567890abcdef", {"id": "m2", "visible": false}],
+          [{"color": "#d62728", "expression": "100 * (m1 / m2)", "id": "e1", "label": "Error rate (%%)"}]
+        ],
+        "period": 300,
+        "region": %[1]q,
+        "stacked": true,
+        "stat": "Sum",
+        "title": "Error rate",
+        "view": "timeSeries"
+      }
+    },
+    {
+      "type": "log",
+      "x": 12,
+      "y": 2,
+      "width": 12,
+      "height": 6,
+      "properties": {
+        "query": "SOURCE '/aws/lambda/example' | fields @timestamp, @message | filter @message like /ERROR/ | limit 20",
+        "region": %[1]q,
+        "title": "Recent errors",
+        "view": "table"
+      }
+    },
+    {
+      "type": "explorer",
+      "x": 0,
+      "y": 8,
+      "width": 24,
+      "height": 6,
+      "properties": {
+        "labels": [{"key": "Environment", "value": "production"}],
+        "metrics": [{"metricName": "CPUUtilization", "resourceType": "AWS::EC2::Instance", "stat": "Average"}],
+        "period": 300,
+        "splitBy": "AvailabilityZone",
+        "title": "EC2 by availability zone",
+        "widgetOptions": {"view": "timeSeries"}
+      }
+    }
+  ]
+}`, region)
+}
+
+const testAccDashboardDocumentDataSourceConfig_alarm = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    x      = 6
+    y      = 10
+    width  = 8
+    height = 4
+
+    alarm {
+      title   = "Alarms"
+      alarms  = ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:example"]
+      sort_by = "stateUpdatedAt"
+      states  = ["ALARM", "INSUFFICIENT_DATA"]
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentExpectedJSON_alarm = `{
+  "widgets": [
+    {
+      "type": "alarm",
+      "x": 6,
+      "y": 10,
+      "width": 8,
+      "height": 4,
+      "properties": {
+        "alarms": ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:example"],
+        "sortBy": "stateUpdatedAt",
+        "states": ["ALARM", "INSUFFICIENT_DATA"],
+        "title": "Alarms"
+      }
+    }
+  ]
+}`
+
+const testAccDashboardDocumentDataSourceConfig_invalidExpression = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      metric_query {
+        id          = "m1"
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+      }
+
+      metric_query {
+        id         = "e1"
+        expression = "m1 + m2"
+      }
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_multipleWidgetTypes = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    text {
+      markdown = "Hello world"
+    }
+
+    metric {
+      metric_query {
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+      }
+    }
+  }
+}
+`
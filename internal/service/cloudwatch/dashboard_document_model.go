@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+type dashboardDocWidgetType string
+
+const (
+	dashboardDocWidgetTypeAlarm    dashboardDocWidgetType = "alarm"
+	dashboardDocWidgetTypeExplorer dashboardDocWidgetType = "explorer"
+	dashboardDocWidgetTypeLog      dashboardDocWidgetType = "log"
+	dashboardDocWidgetTypeMetric   dashboardDocWidgetType = "metric"
+	dashboardDocWidgetTypeText     dashboardDocWidgetType = "text"
+)
+
+type dashboardDocMetricView string
+
+const (
+	dashboardDocMetricViewBar         dashboardDocMetricView = "bar"
+	dashboardDocMetricViewGauge       dashboardDocMetricView = "gauge"
+	dashboardDocMetricViewPie         dashboardDocMetricView = "pie"
+	dashboardDocMetricViewSingleValue dashboardDocMetricView = "singleValue"
+	dashboardDocMetricViewTable       dashboardDocMetricView = "table"
+	dashboardDocMetricViewTimeSeries  dashboardDocMetricView = "timeSeries"
+)
+
+func (dashboardDocMetricView) Values() []dashboardDocMetricView {
+	return []dashboardDocMetricView{
+		dashboardDocMetricViewBar,
+		dashboardDocMetricViewGauge,
+		dashboardDocMetricViewPie,
+		dashboardDocMetricViewSingleValue,
+		dashboardDocMetricViewTable,
+		dashboardDocMetricViewTimeSeries,
+	}
+}
+
+type dashboardDocLogView string
+
+const (
+	dashboardDocLogViewBar        dashboardDocLogView = "bar"
+	dashboardDocLogViewPie        dashboardDocLogView = "pie"
+	dashboardDocLogViewTable      dashboardDocLogView = "table"
+	dashboardDocLogViewTimeSeries dashboardDocLogView = "timeSeries"
+)
+
+func (dashboardDocLogView) Values() []dashboardDocLogView {
+	return []dashboardDocLogView{
+		dashboardDocLogViewBar,
+		dashboardDocLogViewPie,
+		dashboardDocLogViewTable,
+		dashboardDocLogViewTimeSeries,
+	}
+}
+
+type dashboardDocExplorerView string
+
+const (
+	dashboardDocExplorerViewBar         dashboardDocExplorerView = "bar"
+	dashboardDocExplorerViewPie         dashboardDocExplorerView = "pie"
+	dashboardDocExplorerViewSingleValue dashboardDocExplorerView = "singleValue"
+	dashboardDocExplorerViewTimeSeries  dashboardDocExplorerView = "timeSeries"
+)
+
+func (dashboardDocExplorerView) Values() []dashboardDocExplorerView {
+	return []dashboardDocExplorerView{
+		dashboardDocExplorerViewBar,
+		dashboardDocExplorerViewPie,
+		dashboardDocExplorerViewSingleValue,
+		dashboardDocExplorerViewTimeSeries,
+	}
+}
+
+type dashboardDocTextBackground string
+
+const (
+	dashboardDocTextBackgroundSolid       dashboardDocTextBackground = "solid"
+	dashboardDocTextBackgroundTransparent dashboardDocTextBackground = "transparent"
+)
+
+func (dashboardDocTextBackground) Values() []dashboardDocTextBackground {
+	return []dashboardDocTextBackground{
+		dashboardDocTextBackgroundSolid,
+		dashboardDocTextBackgroundTransparent,
+	}
+}
+
+type dashboardDocPeriodOverride string
+
+const (
+	dashboardDocPeriodOverrideAuto    dashboardDocPeriodOverride = "auto"
+	dashboardDocPeriodOverrideInherit dashboardDocPeriodOverride = "inherit"
+)
+
+func (dashboardDocPeriodOverride) Values() []dashboardDocPeriodOverride {
+	return []dashboardDocPeriodOverride{
+		dashboardDocPeriodOverrideAuto,
+		dashboardDocPeriodOverrideInherit,
+	}
+}
+
+type dashboardDocYAxis string
+
+const (
+	dashboardDocYAxisLeft  dashboardDocYAxis = "left"
+	dashboardDocYAxisRight dashboardDocYAxis = "right"
+)
+
+func (dashboardDocYAxis) Values() []dashboardDocYAxis {
+	return []dashboardDocYAxis{
+		dashboardDocYAxisLeft,
+		dashboardDocYAxisRight,
+	}
+}
+
+type cloudWatchDashboardDoc struct {
+	Start          string                `json:"start,omitempty"`
+	End            string                `json:"end,omitempty"`
+	PeriodOverride string                `json:"periodOverride,omitempty"`
+	Widgets        []*dashboardDocWidget `json:"widgets"`
+}
+
+type dashboardDocWidget struct {
+	Type       dashboardDocWidgetType `json:"type"`
+	X          int                    `json:"x"`
+	Y          int                    `json:"y"`
+	Width      int                    `json:"width"`
+	Height     int                    `json:"height"`
+	Properties interface{}            `json:"properties"`
+}
+
+type dashboardDocMetricProperties struct {
+	Metrics [][]interface{} `json:"metrics"`
+	Period  int             `json:"period,omitempty"`
+	Region  string          `json:"region"`
+	Stacked *bool           `json:"stacked,omitempty"`
+	Stat    string          `json:"stat,omitempty"`
+	Title   string          `json:"title,omitempty"`
+	View    string          `json:"view,omitempty"`
+}
+
+// dashboardDocMetricOptions is the trailing rendering-options object of an entry in a metric widget's "metrics" array.
+type dashboardDocMetricOptions struct {
+	Color      string `json:"color,omitempty"`
+	Expression string `json:"expression,omitempty"`
+	ID         string `json:"id,omitempty"`
+	Label      string `json:"label,omitempty"`
+	Period     int    `json:"period,omitempty"`
+	Region     string `json:"region,omitempty"`
+	Stat       string `json:"stat,omitempty"`
+	Visible    *bool  `json:"visible,omitempty"`
+	YAxis      string `json:"yAxis,omitempty"`
+}
+
+type dashboardDocLogProperties struct {
+	Query   string `json:"query"`
+	Region  string `json:"region"`
+	Stacked *bool  `json:"stacked,omitempty"`
+	Title   string `json:"title,omitempty"`
+	View    string `json:"view,omitempty"`
+}
+
+type dashboardDocAlarmProperties struct {
+	Alarms []string `json:"alarms"`
+	SortBy string   `json:"sortBy,omitempty"`
+	States []string `json:"states,omitempty"`
+	Title  string   `json:"title,omitempty"`
+}
+
+type dashboardDocTextProperties struct {
+	Background string `json:"background,omitempty"`
+	Markdown   string `json:"markdown"`
+}
+
+type dashboardDocExplorerProperties struct {
+	Labels        []*dashboardDocExplorerLabel  `json:"labels"`
+	Metrics       []*dashboardDocExplorerMetric `json:"metrics"`
+	Period        int                           `json:"period,omitempty"`
+	SplitBy       string                        `json:"splitBy,omitempty"`
+	Title         string                        `json:"title,omitempty"`
+	WidgetOptions *dashboardDocExplorerOptions  `json:"widgetOptions,omitempty"`
+}
+
+type dashboardDocExplorerLabel struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+type dashboardDocExplorerMetric struct {
+	MetricName   string `json:"metricName"`
+	ResourceType string `json:"resourceType"`
+	Stat         string `json:"stat"`
+}
+
+type dashboardDocExplorerOptions struct {
+	Stacked *bool  `json:"stacked,omitempty"`
+	View    string `json:"view,omitempty"`
+}
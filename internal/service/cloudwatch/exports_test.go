@@ -16,4 +16,11 @@ var (
 	FindMetricAlarmByName            = findMetricAlarmByName
 	FindMetricStreamByName           = findMetricStreamByName
 	FindContributorInsightRuleByName = findContributorInsightRuleByName
+
+	LayoutDashboardDocWidgets    = layoutDashboardDocWidgets
+	ValidateMetricMathExpression = validateMetricMathExpression
+)
+
+type (
+	DashboardDocWidget = dashboardDocWidget
 )
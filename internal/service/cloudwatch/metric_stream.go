@@ -139,7 +139,7 @@ func resourceMetricStream() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			names.AttrState: {
 				Type:     schema.TypeString,
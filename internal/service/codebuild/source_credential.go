@@ -10,12 +10,15 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/codebuild"
 	"github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -26,12 +29,17 @@ func resourceSourceCredential() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSourceCredentialCreate,
 		ReadWithoutTimeout:   resourceSourceCredentialRead,
+		UpdateWithoutTimeout: resourceSourceCredentialUpdate,
 		DeleteWithoutTimeout: resourceSourceCredentialDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("token"), cty.GetAttrPath("token_wo")),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -50,10 +58,23 @@ func resourceSourceCredential() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[types.ServerType](),
 			},
 			"token": {
-				Type:      schema.TypeString,
-				Required:  true,
-				ForceNew:  true,
-				Sensitive: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"token", "token_wo"},
+			},
+			"token_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"token", "token_wo"},
+			},
+			"token_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"token_wo"},
 			},
 			names.AttrUserName: {
 				Type:     schema.TypeString,
@@ -68,6 +89,13 @@ func resourceSourceCredentialCreate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CodeBuildClient(ctx)
 
+	// get write-only value from configuration
+	tokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("token_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
 	authType := types.AuthType(d.Get("auth_type").(string))
 	input := &codebuild.ImportSourceCredentialsInput{
 		AuthType:   authType,
@@ -75,6 +103,10 @@ func resourceSourceCredentialCreate(ctx context.Context, d *schema.ResourceData,
 		Token:      aws.String(d.Get("token").(string)),
 	}
 
+	if tokenWO != "" {
+		input.Token = aws.String(tokenWO)
+	}
+
 	if attr, ok := d.GetOk(names.AttrUserName); ok && authType == types.AuthTypeBasicAuth {
 		input.Username = aws.String(attr.(string))
 	}
@@ -113,6 +145,39 @@ func resourceSourceCredentialRead(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+func resourceSourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeBuildClient(ctx)
+
+	if d.HasChange("token_wo_version") {
+		tokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("token_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		authType := types.AuthType(d.Get("auth_type").(string))
+		input := &codebuild.ImportSourceCredentialsInput{
+			AuthType:        authType,
+			ServerType:      types.ServerType(d.Get("server_type").(string)),
+			ShouldOverwrite: aws.Bool(true),
+			Token:           aws.String(tokenWO),
+		}
+
+		if attr, ok := d.GetOk(names.AttrUserName); ok && authType == types.AuthTypeBasicAuth {
+			input.Username = aws.String(attr.(string))
+		}
+
+		_, err := conn.ImportSourceCredentials(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeBuild Source Credential (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSourceCredentialRead(ctx, d, meta)...)
+}
+
 func resourceSourceCredentialDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CodeBuildClient(ctx)
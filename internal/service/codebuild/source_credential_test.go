@@ -11,9 +11,11 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/codebuild"
 	"github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+	"github.com/hashicorp/go-version"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfcodebuild "github.com/hashicorp/terraform-provider-aws/internal/service/codebuild"
@@ -100,6 +102,42 @@ func TestAccCodeBuildSourceCredential_basicAuth(t *testing.T) {
 	})
 }
 
+func TestAccCodeBuildSourceCredential_tokenWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sourceCredentialsInfo types.SourceCredentialsInfo
+	token1 := sdkacctest.RandomWithPrefix("token")
+	token2 := sdkacctest.RandomWithPrefix("token")
+	resourceName := "aws_codebuild_source_credential.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CodeBuildServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceCredentialDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceCredentialConfig_tokenWriteOnly(token1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceCredentialExists(ctx, resourceName, &sourceCredentialsInfo),
+					resource.TestCheckNoResourceAttr(resourceName, "token_wo"),
+					resource.TestCheckResourceAttr(resourceName, "token_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccSourceCredentialConfig_tokenWriteOnly(token2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceCredentialExists(ctx, resourceName, &sourceCredentialsInfo),
+					resource.TestCheckNoResourceAttr(resourceName, "token_wo"),
+					resource.TestCheckResourceAttr(resourceName, "token_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCodeBuildSourceCredential_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sourceCredentialsInfo types.SourceCredentialsInfo
@@ -212,3 +250,14 @@ resource "aws_codebuild_source_credential" "test" {
 }
 `, token, userName)
 }
+
+func testAccSourceCredentialConfig_tokenWriteOnly(token string, tokenVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_codebuild_source_credential" "test" {
+  auth_type        = "PERSONAL_ACCESS_TOKEN"
+  server_type      = "GITHUB"
+  token_wo         = %[1]q
+  token_wo_version = %[2]d
+}
+`, token, tokenVersion)
+}
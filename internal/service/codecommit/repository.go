@@ -63,7 +63,7 @@ func resourceRepository() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			"repository_id": {
 				Type:     schema.TypeString,
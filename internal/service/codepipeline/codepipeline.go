@@ -118,7 +118,7 @@ func resourcePipeline() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			names.AttrStage: {
 				Type:     schema.TypeList,
@@ -190,7 +190,7 @@ func resourcePipeline() *schema.Resource {
 									names.AttrRoleARN: {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidIAMRoleARN,
 									},
 									"run_order": {
 										Type:         schema.TypeInt,
@@ -74,7 +74,7 @@ func resourcePoolRolesAttachment() *schema.Resource {
 									names.AttrRoleARN: {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidIAMRoleARN,
 									},
 									names.AttrValue: {
 										Type:         schema.TypeString,
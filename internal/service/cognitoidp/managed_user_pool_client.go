@@ -34,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -294,6 +295,9 @@ func (r *managedUserPoolClientResource) Schema(ctx context.Context, request reso
 							CustomType: fwtypes.ARNType,
 							Optional:   true,
 							Computed:   true,
+							Validators: []validator.String{
+								fwvalidators.IAMRoleARN(),
+							},
 						},
 						"user_data_shared": schema.BoolAttribute{
 							Optional: true,
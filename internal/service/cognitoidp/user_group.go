@@ -56,7 +56,7 @@ func resourceUserGroup() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			names.AttrUserPoolID: {
 				Type:         schema.TypeString,
@@ -321,7 +321,7 @@ func resourceUserPool() *schema.Resource {
 						names.AttrKMSKeyID: {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidKMSKeyARN,
 						},
 						"post_authentication": {
 							Type:         schema.TypeString,
@@ -36,6 +36,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -289,6 +290,9 @@ func (r *userPoolClientResource) Schema(ctx context.Context, request resource.Sc
 							CustomType: fwtypes.ARNType,
 							Optional:   true,
 							Computed:   true,
+							Validators: []validator.String{
+								fwvalidators.IAMRoleARN(),
+							},
 						},
 						"user_data_shared": schema.BoolAttribute{
 							Optional: true,
@@ -116,7 +116,7 @@ func resourceConfigurationAggregator() *schema.Resource {
 						names.AttrRoleARN: {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidIAMRoleARN,
 						},
 					},
 				},
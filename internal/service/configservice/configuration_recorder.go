@@ -144,7 +144,7 @@ func resourceConfigurationRecorder() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 		},
 	}
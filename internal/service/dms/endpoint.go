@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -50,6 +51,10 @@ func resourceEndpoint() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath(names.AttrPassword), cty.GetAttrPath("password_wo")),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrCertificateARN: {
 				Type:         schema.TypeString,
@@ -343,7 +348,19 @@ func resourceEndpoint() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"secrets_manager_access_role_arn", "secrets_manager_arn"},
+				ConflictsWith: []string{"password_wo", "secrets_manager_access_role_arn", "secrets_manager_arn"},
+			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{names.AttrPassword, "secrets_manager_access_role_arn", "secrets_manager_arn"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
 			},
 			"pause_replication_tasks": {
 				Type:     schema.TypeBool,
@@ -739,14 +756,14 @@ func resourceEndpoint() *schema.Resource {
 				Optional:      true,
 				ValidateFunc:  verify.ValidARN,
 				RequiredWith:  []string{"secrets_manager_arn"},
-				ConflictsWith: []string{names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort},
+				ConflictsWith: []string{names.AttrUsername, names.AttrPassword, "password_wo", "server_name", names.AttrPort},
 			},
 			"secrets_manager_arn": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ValidateFunc:  verify.ValidARN,
 				RequiredWith:  []string{"secrets_manager_access_role_arn"},
-				ConflictsWith: []string{names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort},
+				ConflictsWith: []string{names.AttrUsername, names.AttrPassword, "password_wo", "server_name", names.AttrPort},
 			},
 			"server_name": {
 				Type:          schema.TypeString,
@@ -786,6 +803,12 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DMSClient(ctx)
 
+	password, di := endpointPassword(d)
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
 	endpointID := d.Get("endpoint_id").(string)
 	input := &dms.CreateEndpointInput{
 		EndpointIdentifier: aws.String(endpointID),
@@ -822,14 +845,14 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 		} else {
 			input.MySQLSettings = &awstypes.MySQLSettings{
 				Username:     aws.String(d.Get(names.AttrUsername).(string)),
-				Password:     aws.String(d.Get(names.AttrPassword).(string)),
+				Password:     aws.String(password),
 				ServerName:   aws.String(d.Get("server_name").(string)),
 				Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 				DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 			}
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, input)
+			expandTopLevelConnectionInfo(d, password, input)
 		}
 	case engineNameAuroraPostgresql, engineNamePostgres:
 		settings := &awstypes.PostgreSQLSettings{}
@@ -843,13 +866,13 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 			settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
 		} else {
 			settings.Username = aws.String(d.Get(names.AttrUsername).(string))
-			settings.Password = aws.String(d.Get(names.AttrPassword).(string))
+			settings.Password = aws.String(password)
 			settings.ServerName = aws.String(d.Get("server_name").(string))
 			settings.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 			settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, input)
+			expandTopLevelConnectionInfo(d, password, input)
 		}
 
 		input.PostgreSQLSettings = settings
@@ -877,12 +900,12 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 			settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 		} else {
 			settings.Username = aws.String(d.Get(names.AttrUsername).(string))
-			settings.Password = aws.String(d.Get(names.AttrPassword).(string))
+			settings.Password = aws.String(password)
 			settings.ServerName = aws.String(d.Get("server_name").(string))
 			settings.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, input)
+			expandTopLevelConnectionInfo(d, password, input)
 		}
 
 		settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
@@ -905,14 +928,14 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 		} else {
 			input.OracleSettings = &awstypes.OracleSettings{
 				Username:     aws.String(d.Get(names.AttrUsername).(string)),
-				Password:     aws.String(d.Get(names.AttrPassword).(string)),
+				Password:     aws.String(password),
 				ServerName:   aws.String(d.Get("server_name").(string)),
 				Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 				DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 			}
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, input)
+			expandTopLevelConnectionInfo(d, password, input)
 		}
 	case engineNameRedis:
 		input.RedisSettings = expandRedisSettings(d.Get("redis_settings").([]interface{})[0].(map[string]interface{}))
@@ -926,12 +949,12 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 			settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 		} else {
 			settings.Username = aws.String(d.Get(names.AttrUsername).(string))
-			settings.Password = aws.String(d.Get(names.AttrPassword).(string))
+			settings.Password = aws.String(password)
 			settings.ServerName = aws.String(d.Get("server_name").(string))
 			settings.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, input)
+			expandTopLevelConnectionInfo(d, password, input)
 		}
 
 		if v, ok := d.GetOk("redshift_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -969,14 +992,14 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 		} else {
 			input.MicrosoftSQLServerSettings = &awstypes.MicrosoftSQLServerSettings{
 				Username:     aws.String(d.Get(names.AttrUsername).(string)),
-				Password:     aws.String(d.Get(names.AttrPassword).(string)),
+				Password:     aws.String(password),
 				ServerName:   aws.String(d.Get("server_name").(string)),
 				Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 				DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 			}
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, input)
+			expandTopLevelConnectionInfo(d, password, input)
 		}
 	case engineNameSybase:
 		if _, ok := d.GetOk("secrets_manager_arn"); ok {
@@ -988,14 +1011,14 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 		} else {
 			input.SybaseSettings = &awstypes.SybaseSettings{
 				Username:     aws.String(d.Get(names.AttrUsername).(string)),
-				Password:     aws.String(d.Get(names.AttrPassword).(string)),
+				Password:     aws.String(password),
 				ServerName:   aws.String(d.Get("server_name").(string)),
 				Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 				DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 			}
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, input)
+			expandTopLevelConnectionInfo(d, password, input)
 		}
 	case engineNameDB2, engineNameDB2zOS:
 		if _, ok := d.GetOk("secrets_manager_arn"); ok {
@@ -1007,19 +1030,19 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 		} else {
 			input.IBMDb2Settings = &awstypes.IBMDb2Settings{
 				Username:     aws.String(d.Get(names.AttrUsername).(string)),
-				Password:     aws.String(d.Get(names.AttrPassword).(string)),
+				Password:     aws.String(password),
 				ServerName:   aws.String(d.Get("server_name").(string)),
 				Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 				DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 			}
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, input)
+			expandTopLevelConnectionInfo(d, password, input)
 		}
 	case engineNameS3:
 		input.S3Settings = expandS3Settings(d.Get("s3_settings").([]interface{})[0].(map[string]interface{}))
 	default:
-		expandTopLevelConnectionInfo(d, input)
+		expandTopLevelConnectionInfo(d, password, input)
 	}
 
 	_, err := tfresource.RetryWhenIsA[*awstypes.AccessDeniedFault](ctx, d.Timeout(schema.TimeoutCreate),
@@ -1064,6 +1087,12 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	conn := meta.(*conns.AWSClient).DMSClient(ctx)
 
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		password, di := endpointPassword(d)
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
 		endpointARN := d.Get("endpoint_arn").(string)
 		pauseTasks := d.Get("pause_replication_tasks").(bool)
 		var tasks []awstypes.ReplicationTask
@@ -1111,7 +1140,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			switch engineName := d.Get("engine_name").(string); engineName {
 			case engineNameAurora, engineNameMariadb, engineNameMySQL:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
 					"secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
 						input.MySQLSettings = &awstypes.MySQLSettings{
@@ -1121,7 +1150,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 					} else {
 						input.MySQLSettings = &awstypes.MySQLSettings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1129,12 +1158,12 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 						input.EngineName = aws.String(engineName)
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, input)
+						expandTopLevelConnectionInfoModify(d, password, input)
 					}
 				}
 			case engineNameAuroraPostgresql, engineNamePostgres:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
 					"secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
 						input.PostgreSQLSettings = &awstypes.PostgreSQLSettings{
@@ -1145,7 +1174,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 					} else {
 						input.PostgreSQLSettings = &awstypes.PostgreSQLSettings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1153,7 +1182,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 						input.EngineName = aws.String(engineName) // Must be included (should be 'postgres')
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, input)
+						expandTopLevelConnectionInfoModify(d, password, input)
 					}
 				}
 			case engineNameDynamoDB:
@@ -1190,7 +1219,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				}
 			case engineNameMongodb:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "mongodb_settings.0.auth_type",
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName, "mongodb_settings.0.auth_type",
 					"mongodb_settings.0.auth_mechanism", "mongodb_settings.0.nesting_level", "mongodb_settings.0.extract_doc_id",
 					"mongodb_settings.0.docs_to_investigate", "mongodb_settings.0.auth_source", "secrets_manager_access_role_arn",
 					"secrets_manager_arn") {
@@ -1211,7 +1240,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 					} else {
 						input.MongoDbSettings = &awstypes.MongoDbSettings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1227,12 +1256,12 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 						input.EngineName = aws.String(engineName)
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, input)
+						expandTopLevelConnectionInfoModify(d, password, input)
 					}
 				}
 			case engineNameOracle:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
 					"secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
 						input.OracleSettings = &awstypes.OracleSettings{
@@ -1243,7 +1272,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 					} else {
 						input.OracleSettings = &awstypes.OracleSettings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1251,7 +1280,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 						input.EngineName = aws.String(engineName) // Must be included (should be 'oracle')
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, input)
+						expandTopLevelConnectionInfoModify(d, password, input)
 					}
 				}
 			case engineNameRedis:
@@ -1261,7 +1290,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				}
 			case engineNameRedshift:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName,
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName,
 					"redshift_settings", "secrets_manager_access_role_arn",
 					"secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
@@ -1273,7 +1302,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 					} else {
 						input.RedshiftSettings = &awstypes.RedshiftSettings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1281,7 +1310,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 						input.EngineName = aws.String(engineName) // Must be included (should be 'redshift')
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, input)
+						expandTopLevelConnectionInfoModify(d, password, input)
 
 						if v, ok := d.GetOk("redshift_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 							tfMap := v.([]interface{})[0].(map[string]interface{})
@@ -1310,7 +1339,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				}
 			case engineNameSQLServer, engineNameBabelfish:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
 					"secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
 						input.MicrosoftSQLServerSettings = &awstypes.MicrosoftSQLServerSettings{
@@ -1321,7 +1350,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 					} else {
 						input.MicrosoftSQLServerSettings = &awstypes.MicrosoftSQLServerSettings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1329,12 +1358,12 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 						input.EngineName = aws.String(engineName) // Must be included (should be 'postgres')
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, input)
+						expandTopLevelConnectionInfoModify(d, password, input)
 					}
 				}
 			case engineNameSybase:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
 					"secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
 						input.SybaseSettings = &awstypes.SybaseSettings{
@@ -1345,7 +1374,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 					} else {
 						input.SybaseSettings = &awstypes.SybaseSettings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1353,12 +1382,12 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 						input.EngineName = aws.String(engineName) // Must be included (should be 'postgres')
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, input)
+						expandTopLevelConnectionInfoModify(d, password, input)
 					}
 				}
 			case engineNameDB2, engineNameDB2zOS:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
 					"secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
 						input.IBMDb2Settings = &awstypes.IBMDb2Settings{
@@ -1369,7 +1398,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 					} else {
 						input.IBMDb2Settings = &awstypes.IBMDb2Settings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1377,7 +1406,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 						input.EngineName = aws.String(engineName) // Must be included (should be 'db2')
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, input)
+						expandTopLevelConnectionInfoModify(d, password, input)
 					}
 				}
 			case engineNameS3:
@@ -1390,8 +1419,8 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 					input.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
 				}
 
-				if d.HasChange(names.AttrPassword) {
-					input.Password = aws.String(d.Get(names.AttrPassword).(string))
+				if d.HasChanges(names.AttrPassword, "password_wo_version") {
+					input.Password = aws.String(password)
 				}
 
 				if d.HasChange(names.AttrPort) {
@@ -2600,9 +2629,19 @@ func engineSettingsToSet(l []interface{}) *schema.Set {
 	return s
 }
 
-func expandTopLevelConnectionInfo(d *schema.ResourceData, input *dms.CreateEndpointInput) {
+// endpointPassword returns the configured endpoint password, preferring the write-only value.
+func endpointPassword(d *schema.ResourceData) (string, diag.Diagnostics) {
+	passwordWO, diags := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	if passwordWO != "" {
+		return passwordWO, diags
+	}
+
+	return d.Get(names.AttrPassword).(string), diags
+}
+
+func expandTopLevelConnectionInfo(d *schema.ResourceData, password string, input *dms.CreateEndpointInput) {
 	input.Username = aws.String(d.Get(names.AttrUsername).(string))
-	input.Password = aws.String(d.Get(names.AttrPassword).(string))
+	input.Password = aws.String(password)
 	input.ServerName = aws.String(d.Get("server_name").(string))
 	input.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
@@ -2611,9 +2650,9 @@ func expandTopLevelConnectionInfo(d *schema.ResourceData, input *dms.CreateEndpo
 	}
 }
 
-func expandTopLevelConnectionInfoModify(d *schema.ResourceData, input *dms.ModifyEndpointInput) {
+func expandTopLevelConnectionInfoModify(d *schema.ResourceData, password string, input *dms.ModifyEndpointInput) {
 	input.Username = aws.String(d.Get(names.AttrUsername).(string))
-	input.Password = aws.String(d.Get(names.AttrPassword).(string))
+	input.Password = aws.String(password)
 	input.ServerName = aws.String(d.Get("server_name").(string))
 	input.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
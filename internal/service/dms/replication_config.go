@@ -76,7 +76,7 @@ func resourceReplicationConfig() *schema.Resource {
 							Optional:     true,
 							Computed:     true,
 							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidKMSKeyARN,
 						},
 						"max_capacity_units": {
 							Type:     schema.TypeInt,
@@ -11,6 +11,7 @@ var (
 	ResourceTable                       = resourceTable
 	ResourceTableExport                 = resourceTableExport
 	ResourceTableItem                   = resourceTableItem
+	ResourceTableItems                  = resourceTableItems
 	ResourceTableReplica                = resourceTableReplica
 	ResourceTag                         = resourceTag
 	ResourceResourcePolicy              = newResourcePolicyResource
@@ -19,6 +20,7 @@ var (
 	ContributorInsightsParseResourceID           = contributorInsightsParseResourceID
 	ExpandTableItemAttributes                    = expandTableItemAttributes
 	ExpandTableItemQueryKey                      = expandTableItemQueryKey
+	ExpandTableItems                             = expandTableItems
 	FindContributorInsightsByTwoPartKey          = findContributorInsightsByTwoPartKey
 	FindGlobalTableByName                        = findGlobalTableByName
 	FindKinesisDataStreamDestinationByTwoPartKey = findKinesisDataStreamDestinationByTwoPartKey
@@ -26,6 +28,7 @@ var (
 	FindTableByName                              = findTableByName
 	FindTableExportByARN                         = findTableExportByARN
 	FindTableItemByTwoPartKey                    = findTableItemByTwoPartKey
+	FindTableItemsByKeys                         = findTableItemsByKeys
 	FindTag                                      = findTag
 	FlattenTableItemAttributes                   = flattenTableItemAttributes
 	ListTags                                     = listTags
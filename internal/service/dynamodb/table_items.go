@@ -0,0 +1,412 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchWriteItem.html.
+	batchWriteItemMaxRequests = 25
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchGetItem.html.
+	batchGetItemMaxKeys = 100
+
+	tableItemsTimeout = 10 * time.Minute
+)
+
+// @SDKResource("aws_dynamodb_table_items", name="Table Items")
+func resourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableItemsCreate,
+		ReadWithoutTimeout:   resourceTableItemsRead,
+		UpdateWithoutTimeout: resourceTableItemsUpdate,
+		DeleteWithoutTimeout: resourceTableItemsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(tableItemsTimeout),
+			Read:   schema.DefaultTimeout(tableItemsTimeout),
+			Delete: schema.DefaultTimeout(tableItemsTimeout),
+			Update: schema.DefaultTimeout(tableItemsTimeout),
+		},
+
+		CustomizeDiff: validateTableItemsKeys,
+
+		Schema: map[string]*schema.Schema{
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"items": {
+				Type:                  schema.TypeMap,
+				Required:              true,
+				Elem:                  &schema.Schema{Type: schema.TypeString},
+				ValidateFunc:          validateTableItems,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+			},
+			names.AttrTableName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func validateTableItems(v interface{}, k string) (ws []string, errors []error) {
+	for key, item := range v.(map[string]interface{}) {
+		if _, err := expandTableItemAttributes(item.(string)); err != nil {
+			errors = append(errors, fmt.Errorf("Invalid format of %q: %s", fmt.Sprintf("%s.%s", k, key), err))
+		}
+	}
+	return
+}
+
+// validateTableItemsKeys ensures that every item has a primary key and that no two items share one.
+func validateTableItemsKeys(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("items") {
+		return nil
+	}
+
+	hashKey, rangeKey := d.Get("hash_key").(string), d.Get("range_key").(string)
+	if _, err := expandTableItems(d.Get("items").(map[string]interface{}), hashKey, rangeKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceTableItemsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	items, err := expandTableItems(d.Get("items").(map[string]interface{}), d.Get("hash_key").(string), d.Get("range_key").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	requests := make([]awstypes.WriteRequest, 0, len(items))
+	for _, key := range slices.Sorted(maps.Keys(items)) {
+		requests = append(requests, items[key].putRequest())
+	}
+
+	if err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	d.SetId(tableName)
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName, hashKey, rangeKey := d.Get(names.AttrTableName).(string), d.Get("hash_key").(string), d.Get("range_key").(string)
+	items, err := expandTableItems(d.Get("items").(map[string]interface{}), hashKey, rangeKey)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	keys := make([]map[string]awstypes.AttributeValue, 0, len(items))
+	for _, key := range slices.Sorted(maps.Keys(items)) {
+		keys = append(keys, items[key].queryKey)
+	}
+
+	found, err := findTableItemsByKeys(ctx, conn, tableName, keys, d.Timeout(schema.TimeoutRead))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table Items (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	byID := make(map[string]map[string]awstypes.AttributeValue, len(found))
+	for _, item := range found {
+		byID[tableItemsKeyID(hashKey, rangeKey, item)] = item
+	}
+
+	tfMap := make(map[string]string, len(items))
+	for key, item := range items {
+		v, ok := byID[item.id]
+		if !ok {
+			log.Printf("[WARN] DynamoDB Table Item (%s) not found, removing from state", item.id)
+			continue
+		}
+
+		if reflect.DeepEqual(v, item.attributes) {
+			tfMap[key] = item.raw
+			continue
+		}
+
+		tfMap[key], err = flattenTableItemAttributes(v)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	d.Set("items", tfMap)
+
+	return diags
+}
+
+func resourceTableItemsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	if d.HasChange("items") {
+		tableName, hashKey, rangeKey := d.Get(names.AttrTableName).(string), d.Get("hash_key").(string), d.Get("range_key").(string)
+
+		o, n := d.GetChange("items")
+		oldItems, err := expandTableItems(o.(map[string]interface{}), hashKey, rangeKey)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		newItems, err := expandTableItems(n.(map[string]interface{}), hashKey, rangeKey)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		newIDs := make(map[string]bool, len(newItems))
+		var puts []awstypes.WriteRequest
+		for _, key := range slices.Sorted(maps.Keys(newItems)) {
+			item := newItems[key]
+			newIDs[item.id] = true
+
+			if old, ok := oldItems[key]; ok && reflect.DeepEqual(old.attributes, item.attributes) {
+				continue
+			}
+
+			puts = append(puts, item.putRequest())
+		}
+
+		// Items whose primary key is no longer in use are deleted.
+		var deletes []awstypes.WriteRequest
+		for _, key := range slices.Sorted(maps.Keys(oldItems)) {
+			if item := oldItems[key]; !newIDs[item.id] {
+				deletes = append(deletes, item.deleteRequest())
+			}
+		}
+
+		if err := batchWriteTableItems(ctx, conn, tableName, puts, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table Items (%s): %s", d.Id(), err)
+		}
+
+		if err := batchWriteTableItems(ctx, conn, tableName, deletes, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table Items (%s): removing old items: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	items, err := expandTableItems(d.Get("items").(map[string]interface{}), d.Get("hash_key").(string), d.Get("range_key").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	requests := make([]awstypes.WriteRequest, 0, len(items))
+	for _, key := range slices.Sorted(maps.Keys(items)) {
+		requests = append(requests, items[key].deleteRequest())
+	}
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table Items: %s", d.Id())
+	err = batchWriteTableItems(ctx, conn, d.Get(names.AttrTableName).(string), requests, d.Timeout(schema.TimeoutDelete))
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+type tableItem struct {
+	attributes map[string]awstypes.AttributeValue
+	id         string
+	queryKey   map[string]awstypes.AttributeValue
+	raw        string
+}
+
+func (item tableItem) putRequest() awstypes.WriteRequest {
+	return awstypes.WriteRequest{
+		PutRequest: &awstypes.PutRequest{
+			Item: item.attributes,
+		},
+	}
+}
+
+func (item tableItem) deleteRequest() awstypes.WriteRequest {
+	return awstypes.WriteRequest{
+		DeleteRequest: &awstypes.DeleteRequest{
+			Key: item.queryKey,
+		},
+	}
+}
+
+// expandTableItems decodes the items and their primary keys.
+// An error is returned if an item is missing a key attribute or if two items have the same primary key.
+func expandTableItems(tfMap map[string]interface{}, hashKey, rangeKey string) (map[string]tableItem, error) {
+	items := make(map[string]tableItem, len(tfMap))
+	keys := make(map[string]string, len(tfMap))
+
+	for _, key := range slices.Sorted(maps.Keys(tfMap)) {
+		raw := tfMap[key].(string)
+		attributes, err := expandTableItemAttributes(raw)
+		if err != nil {
+			return nil, fmt.Errorf("item %q: %w", key, err)
+		}
+
+		for _, k := range []string{hashKey, rangeKey} {
+			if _, ok := attributes[k]; k != "" && !ok {
+				return nil, fmt.Errorf("item %q: missing key attribute %q", key, k)
+			}
+		}
+
+		id := tableItemsKeyID(hashKey, rangeKey, attributes)
+		if other, ok := keys[id]; ok {
+			return nil, fmt.Errorf("items %q and %q have the same primary key", other, key)
+		}
+		keys[id] = key
+
+		items[key] = tableItem{
+			attributes: attributes,
+			id:         id,
+			queryKey:   expandTableItemQueryKey(attributes, hashKey, rangeKey),
+			raw:        raw,
+		}
+	}
+
+	return items, nil
+}
+
+// tableItemsKeyID returns a string that uniquely identifies an item's primary key within a table.
+func tableItemsKeyID(hashKey, rangeKey string, attributes map[string]awstypes.AttributeValue) string {
+	return tableItemCreateResourceID("", hashKey, rangeKey, attributes)
+}
+
+// batchWriteTableItems writes the requests in batches, retrying unprocessed items until the timeout expires.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, requests []awstypes.WriteRequest, timeout time.Duration) error {
+	for chunk := range slices.Chunk(requests, batchWriteItemMaxRequests) {
+		input := &dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]awstypes.WriteRequest{
+				tableName: chunk,
+			},
+		}
+
+		err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
+			output, err := conn.BatchWriteItem(ctx, input)
+
+			if errs.IsA[*awstypes.ProvisionedThroughputExceededException](err) || errs.IsA[*awstypes.RequestLimitExceeded](err) {
+				return retry.RetryableError(err)
+			}
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			if unprocessed := output.UnprocessedItems[tableName]; len(unprocessed) > 0 {
+				input.RequestItems[tableName] = unprocessed
+				return retry.RetryableError(fmt.Errorf("%d unprocessed items", len(unprocessed)))
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findTableItemsByKeys returns the items with the specified primary keys that exist, retrying unprocessed keys until the timeout expires.
+func findTableItemsByKeys(ctx context.Context, conn *dynamodb.Client, tableName string, keys []map[string]awstypes.AttributeValue, timeout time.Duration) ([]map[string]awstypes.AttributeValue, error) {
+	var items []map[string]awstypes.AttributeValue
+
+	for chunk := range slices.Chunk(keys, batchGetItemMaxKeys) {
+		input := &dynamodb.BatchGetItemInput{
+			RequestItems: map[string]awstypes.KeysAndAttributes{
+				tableName: {
+					ConsistentRead: aws.Bool(true),
+					Keys:           chunk,
+				},
+			},
+		}
+
+		err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
+			output, err := conn.BatchGetItem(ctx, input)
+
+			if errs.IsA[*awstypes.ProvisionedThroughputExceededException](err) || errs.IsA[*awstypes.RequestLimitExceeded](err) {
+				return retry.RetryableError(err)
+			}
+
+			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				return retry.NonRetryableError(&retry.NotFoundError{
+					LastError:   err,
+					LastRequest: input,
+				})
+			}
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			items = append(items, output.Responses[tableName]...)
+
+			if unprocessed := output.UnprocessedKeys[tableName]; len(unprocessed.Keys) > 0 {
+				input.RequestItems = output.UnprocessedKeys
+				return retry.RetryableError(fmt.Errorf("%d unprocessed keys", len(unprocessed.Keys)))
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
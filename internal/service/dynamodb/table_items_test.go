@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandTableItems(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		items       map[string]interface{}
+		rangeKey    string
+		expectedErr string
+	}{
+		"valid": {
+			items: map[string]interface{}{
+				"one": `{"pk":{"S":"one"},"sk":{"N":"1"}}`,
+				"two": `{"pk":{"S":"one"},"sk":{"N":"2"}}`,
+			},
+			rangeKey: "sk",
+		},
+		"missing hash key": {
+			items: map[string]interface{}{
+				"one": `{"sk":{"N":"1"}}`,
+			},
+			rangeKey:    "sk",
+			expectedErr: `item "one": missing key attribute "pk"`,
+		},
+		"missing range key": {
+			items: map[string]interface{}{
+				"one": `{"pk":{"S":"one"}}`,
+			},
+			rangeKey:    "sk",
+			expectedErr: `item "one": missing key attribute "sk"`,
+		},
+		"duplicate primary key": {
+			items: map[string]interface{}{
+				"one": `{"pk":{"S":"same"},"attr":{"S":"one"}}`,
+				"two": `{"pk":{"S":"same"},"attr":{"S":"two"}}`,
+			},
+			expectedErr: `items "one" and "two" have the same primary key`,
+		},
+		"invalid JSON": {
+			items: map[string]interface{}{
+				"one": `{"pk":"one"}`,
+			},
+			expectedErr: `item "one": unexpected raw attribute type`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			items, err := tfdynamodb.ExpandTableItems(tc.items, "pk", tc.rangeKey)
+
+			if tc.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := len(items), len(tc.items); got != expected {
+				t.Errorf("got %d items, expected %d", got, expected)
+			}
+		})
+	}
+}
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// More items than fit in a single BatchWriteItem request.
+				Config: testAccTableItemsConfig_basic(rName, 60, "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 60),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "pk"),
+					resource.TestCheckResourceAttr(resourceName, "items.%", "60"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTableName, rName),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "items.item7", `{"pk":{"S":"item7"},"value":{"S":"initial"},"n":{"N":"7"}}`),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_basic(rName, 30, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 30),
+					resource.TestCheckResourceAttr(resourceName, "items.%", "30"),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "items.item7", `{"pk":{"S":"item7"},"value":{"S":"updated"},"n":{"N":"7"}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_rangeKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_rangeKey(rName, `{"pk":{"S":"a"},"sk":{"N":"1"}}`, `{"pk":{"S":"a"},"sk":{"N":"2"}}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "range_key", "sk"),
+				),
+			},
+			{
+				// Changing an item's primary key replaces the item.
+				Config: testAccTableItemsConfig_rangeKey(rName, `{"pk":{"S":"a"},"sk":{"N":"1"}}`, `{"pk":{"S":"b"},"sk":{"N":"2"}}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 2),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "items.second", `{"pk":{"S":"b"},"sk":{"N":"2"}}`),
+				),
+			},
+			{
+				Config:      testAccTableItemsConfig_rangeKey(rName, `{"pk":{"S":"a"},"sk":{"N":"1"}}`, `{"pk":{"S":"a"},"sk":{"N":"1"}}`),
+				ExpectError: regexache.MustCompile(`have the same primary key`),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, 3, "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 3),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdynamodb.ResourceTableItems(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_items" {
+				continue
+			}
+
+			tfMap := make(map[string]interface{})
+			for k, v := range rs.Primary.Attributes {
+				if key, ok := strings.CutPrefix(k, "items."); ok && key != "%" {
+					tfMap[key] = v
+				}
+			}
+
+			items, err := tfdynamodb.ExpandTableItems(tfMap, rs.Primary.Attributes["hash_key"], rs.Primary.Attributes["range_key"])
+			if err != nil {
+				return err
+			}
+
+			var keys []map[string]awstypes.AttributeValue
+			for _, v := range tfMap {
+				attributes, err := tfdynamodb.ExpandTableItemAttributes(v.(string))
+				if err != nil {
+					return err
+				}
+
+				keys = append(keys, tfdynamodb.ExpandTableItemQueryKey(attributes, rs.Primary.Attributes["hash_key"], rs.Primary.Attributes["range_key"]))
+			}
+
+			found, err := tfdynamodb.FindTableItemsByKeys(ctx, conn, rs.Primary.Attributes[names.AttrTableName], keys, 5*time.Minute)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(found) > 0 {
+				return fmt.Errorf("DynamoDB Table Items %s still exist (%d of %d)", rs.Primary.ID, len(found), len(items))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTableItemsConfig_basic(rName string, count int, value string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = {
+    for i in range(%[2]d) : "item${i}" => jsonencode({
+      pk    = { S = "item${i}" }
+      value = { S = %[3]q }
+      n     = { N = tostring(i) }
+    })
+  }
+}
+`, rName, count, value)
+}
+
+func testAccTableItemsConfig_rangeKey(rName, first, second string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+  range_key    = "sk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "N"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  items = {
+    first  = %[2]q
+    second = %[3]q
+  }
+}
+`, rName, first, second)
+}
@@ -83,7 +83,7 @@ func resourceEBSVolume() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			"multi_attach_enabled": {
 				Type:     schema.TypeBool,
@@ -86,7 +86,7 @@ func resourceLaunchTemplate() *schema.Resource {
 									names.AttrKMSKeyID: {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidKMSKeyARN,
 									},
 									names.AttrSnapshotID: {
 										Type:     schema.TypeString,
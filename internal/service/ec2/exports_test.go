@@ -176,6 +176,7 @@ var (
 	FindNATGatewayByID                                         = findNATGatewayByID
 	FindNetworkACLAssociationByID                              = findNetworkACLAssociationByID
 	FindNetworkACLByID                                         = findNetworkACLByID
+	FindNetworkACLRuleNumbersByID                              = findNetworkACLRuleNumbersByID
 	FindNetworkACLEntryByThreePartKey                          = findNetworkACLEntryByThreePartKey
 	FindNetworkInsightsAnalysisByID                            = findNetworkInsightsAnalysisByID
 	FindNetworkInsightsPathByID                                = findNetworkInsightsPathByID
@@ -190,6 +191,7 @@ var (
 	FindRouteTableAssociationByID                              = findRouteTableAssociationByID
 	FindRouteTableByID                                         = findRouteTableByID
 	FindSecurityGroupByID                                      = findSecurityGroupByID
+	FindSecurityGroupRuleIDsBySecurityGroupID                  = findSecurityGroupRuleIDsBySecurityGroupID
 	FindSecurityGroupEgressRuleByID                            = findSecurityGroupEgressRuleByID
 	FindSecurityGroupIngressRuleByID                           = findSecurityGroupIngressRuleByID
 	FindSnapshot                                               = findSnapshot
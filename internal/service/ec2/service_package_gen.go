@@ -93,6 +93,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_eip_domain_name",
 			Name:     "EIP Domain Name",
 		},
+		{
+			Factory:  newResourceNetworkACLRulesExclusive,
+			TypeName: "aws_network_acl_rules_exclusive",
+			Name:     "Network ACL Rules Exclusive",
+		},
 		{
 			Factory:  newVPCBlockPublicAccessExclusionResource,
 			TypeName: "aws_vpc_block_public_access_exclusion",
@@ -132,6 +137,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  newResourceSecurityGroupRulesExclusive,
+			TypeName: "aws_vpc_security_group_rules_exclusive",
+			Name:     "Security Group Rules Exclusive",
+		},
 		{
 			Factory:  newResourceSecurityGroupVPCAssociation,
 			TypeName: "aws_vpc_security_group_vpc_association",
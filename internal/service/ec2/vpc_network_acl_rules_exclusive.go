@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_network_acl_rules_exclusive", name="Network ACL Rules Exclusive")
+func newResourceNetworkACLRulesExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceNetworkACLRulesExclusive{}, nil
+}
+
+const (
+	ResNameNetworkACLRulesExclusive = "Network ACL Rules Exclusive"
+)
+
+type resourceNetworkACLRulesExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *resourceNetworkACLRulesExclusive) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_network_acl_rules_exclusive"
+}
+
+func (r *resourceNetworkACLRulesExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"egress_rule_numbers": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"ingress_rule_numbers": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"network_acl_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceNetworkACLRulesExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceNetworkACLRulesExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ingressRuleNumbers, egressRuleNumbers []int32
+	resp.Diagnostics.Append(plan.IngressRuleNumbers.ElementsAs(ctx, &ingressRuleNumbers, false)...)
+	resp.Diagnostics.Append(plan.EgressRuleNumbers.ElementsAs(ctx, &egressRuleNumbers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncRules(ctx, plan.NetworkACLID.ValueString(), ingressRuleNumbers, egressRuleNumbers)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameNetworkACLRulesExclusive, plan.NetworkACLID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceNetworkACLRulesExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().EC2Client(ctx)
+
+	var state resourceNetworkACLRulesExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ingressRuleNumbers, egressRuleNumbers, err := findNetworkACLRuleNumbersByID(ctx, conn, state.NetworkACLID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameNetworkACLRulesExclusive, state.NetworkACLID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.IngressRuleNumbers = flex.FlattenFrameworkInt32ValueSetLegacy(ctx, ingressRuleNumbers)
+	state.EgressRuleNumbers = flex.FlattenFrameworkInt32ValueSetLegacy(ctx, egressRuleNumbers)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceNetworkACLRulesExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceNetworkACLRulesExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.IngressRuleNumbers.Equal(state.IngressRuleNumbers) || !plan.EgressRuleNumbers.Equal(state.EgressRuleNumbers) {
+		var ingressRuleNumbers, egressRuleNumbers []int32
+		resp.Diagnostics.Append(plan.IngressRuleNumbers.ElementsAs(ctx, &ingressRuleNumbers, false)...)
+		resp.Diagnostics.Append(plan.EgressRuleNumbers.ElementsAs(ctx, &egressRuleNumbers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncRules(ctx, plan.NetworkACLID.ValueString(), ingressRuleNumbers, egressRuleNumbers)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EC2, create.ErrActionUpdating, ResNameNetworkACLRulesExclusive, plan.NetworkACLID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncRules handles keeping the configured network ACL rules
+// in sync with the remote resource.
+//
+// Rules in the network ACL but not configured on this resource
+// will be deleted. Rules are created by other resources, so configured
+// rules missing from the network ACL are an error.
+func (r *resourceNetworkACLRulesExclusive) syncRules(ctx context.Context, naclID string, wantIngress, wantEgress []int32) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	haveIngress, haveEgress, err := findNetworkACLRuleNumbersByID(ctx, conn, naclID)
+	if err != nil {
+		return err
+	}
+
+	deleteEntry := func(egress bool) func(context.Context, int32) error {
+		return func(ctx context.Context, ruleNumber int32) error {
+			_, err := conn.DeleteNetworkAclEntry(ctx, &ec2.DeleteNetworkAclEntryInput{
+				Egress:       aws.Bool(egress),
+				NetworkAclId: aws.String(naclID),
+				RuleNumber:   aws.Int32(ruleNumber),
+			})
+
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkACLEntryNotFound) {
+				return nil
+			}
+
+			return err
+		}
+	}
+
+	ingress := framework.ExclusiveMembers[int32]{
+		Have:   haveIngress,
+		Want:   wantIngress,
+		Remove: deleteEntry(false),
+	}
+	if err := ingress.Sync(ctx); err != nil {
+		return err
+	}
+
+	egress := framework.ExclusiveMembers[int32]{
+		Have:   haveEgress,
+		Want:   wantEgress,
+		Remove: deleteEntry(true),
+	}
+
+	return egress.Sync(ctx)
+}
+
+func (r *resourceNetworkACLRulesExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("network_acl_id"), req, resp)
+}
+
+// findNetworkACLRuleNumbersByID returns the numbers of the ingress and egress rules of a network ACL.
+// The default rules, which can be neither modified nor deleted, are not returned.
+func findNetworkACLRuleNumbersByID(ctx context.Context, conn *ec2.Client, id string) ([]int32, []int32, error) {
+	nacl, err := findNetworkACLByID(ctx, conn, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ingress, egress []int32
+	for _, v := range nacl.Entries {
+		ruleNumber := aws.ToInt32(v.RuleNumber)
+		if ruleNumber == defaultACLRuleNumberIPv4 || ruleNumber == defaultACLRuleNumberIPv6 {
+			continue
+		}
+
+		if aws.ToBool(v.Egress) {
+			egress = append(egress, ruleNumber)
+		} else {
+			ingress = append(ingress, ruleNumber)
+		}
+	}
+
+	return ingress, egress, nil
+}
+
+type resourceNetworkACLRulesExclusiveData struct {
+	EgressRuleNumbers  types.Set    `tfsdk:"egress_rule_numbers"`
+	IngressRuleNumbers types.Set    `tfsdk:"ingress_rule_numbers"`
+	NetworkACLID       types.String `tfsdk:"network_acl_id"`
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCNetworkACLRulesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_network_acl_rules_exclusive.test"
+	networkACLResourceName := "aws_network_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkACLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkACLRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkACLRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "network_acl_id", networkACLResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rule_numbers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ingress_rule_numbers.*", "100"),
+					resource.TestCheckResourceAttr(resourceName, "egress_rule_numbers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "egress_rule_numbers.*", "100"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "network_acl_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "network_acl_id",
+			},
+		},
+	})
+}
+
+// A rule added out of band should be deleted
+func TestAccVPCNetworkACLRulesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var nacl awstypes.NetworkAcl
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_network_acl_rules_exclusive.test"
+	networkACLResourceName := "aws_network_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkACLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkACLRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkACLExists(ctx, networkACLResourceName, &nacl),
+					testAccCheckNetworkACLRulesExclusiveExists(ctx, resourceName),
+					testAccCheckNetworkACLRulesExclusiveCreateEntry(ctx, &nacl, 200),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCNetworkACLRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkACLRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rule_numbers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ingress_rule_numbers.*", "100"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkACLRulesExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameNetworkACLRulesExclusive, n, errors.New("not found"))
+		}
+
+		naclID := rs.Primary.Attributes["network_acl_id"]
+		if naclID == "" {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameNetworkACLRulesExclusive, n, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+		ingress, egress, err := tfec2.FindNetworkACLRuleNumbersByID(ctx, conn, naclID)
+		if err != nil {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameNetworkACLRulesExclusive, naclID, err)
+		}
+
+		if rs.Primary.Attributes["ingress_rule_numbers.#"] != strconv.Itoa(len(ingress)) {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameNetworkACLRulesExclusive, naclID, errors.New("unexpected ingress_rule_numbers count"))
+		}
+		if rs.Primary.Attributes["egress_rule_numbers.#"] != strconv.Itoa(len(egress)) {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameNetworkACLRulesExclusive, naclID, errors.New("unexpected egress_rule_numbers count"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkACLRulesExclusiveCreateEntry(ctx context.Context, nacl *awstypes.NetworkAcl, ruleNumber int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := conn.CreateNetworkAclEntry(ctx, &ec2.CreateNetworkAclEntryInput{
+			CidrBlock:    aws.String("10.0.0.0/8"),
+			Egress:       aws.Bool(false),
+			NetworkAclId: nacl.NetworkAclId,
+			PortRange: &awstypes.PortRange{
+				From: aws.Int32(443),
+				To:   aws.Int32(443),
+			},
+			Protocol:   aws.String("6"),
+			RuleAction: awstypes.RuleActionAllow,
+			RuleNumber: aws.Int32(ruleNumber),
+		})
+
+		return err
+	}
+}
+
+func testAccVPCNetworkACLRulesExclusiveConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_acl" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_acl_rule" "ingress" {
+  network_acl_id = aws_network_acl.test.id
+  rule_number    = 100
+  egress         = false
+  protocol       = "tcp"
+  rule_action    = "allow"
+  cidr_block     = "10.0.0.0/8"
+  from_port      = 80
+  to_port        = 80
+}
+
+resource "aws_network_acl_rule" "egress" {
+  network_acl_id = aws_network_acl.test.id
+  rule_number    = 100
+  egress         = true
+  protocol       = "-1"
+  rule_action    = "allow"
+  cidr_block     = "0.0.0.0/0"
+}
+
+resource "aws_network_acl_rules_exclusive" "test" {
+  network_acl_id       = aws_network_acl.test.id
+  ingress_rule_numbers = [aws_network_acl_rule.ingress.rule_number]
+  egress_rule_numbers  = [aws_network_acl_rule.egress.rule_number]
+}
+`, rName)
+}
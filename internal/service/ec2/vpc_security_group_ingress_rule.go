@@ -184,13 +184,15 @@ func (r *securityGroupRuleResource) Schema(ctx context.Context, request resource
 				},
 			},
 			"cidr_ipv4": schema.StringAttribute{
-				Optional: true,
+				CustomType: fwtypes.CIDRBlockType,
+				Optional:   true,
 				Validators: []validator.String{
 					fwvalidators.IPv4CIDRNetworkAddress(),
 				},
 			},
 			"cidr_ipv6": schema.StringAttribute{
-				Optional: true,
+				CustomType: fwtypes.CIDRBlockType,
+				Optional:   true,
 				Validators: []validator.String{
 					fwvalidators.IPv6CIDRNetworkAddress(),
 				},
@@ -291,8 +293,8 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, request resource.R
 	}
 
 	data.ARN = r.securityGroupRuleARN(ctx, data.ID.ValueString())
-	data.CIDRIPv4 = fwflex.StringToFramework(ctx, output.CidrIpv4)
-	data.CIDRIPv6 = fwflex.StringToFramework(ctx, output.CidrIpv6)
+	data.CIDRIPv4 = fwflex.StringToFrameworkValuable[fwtypes.CIDRBlock](ctx, output.CidrIpv4)
+	data.CIDRIPv6 = fwflex.StringToFrameworkValuable[fwtypes.CIDRBlock](ctx, output.CidrIpv6)
 	data.Description = fwflex.StringToFramework(ctx, output.Description)
 	data.IPProtocol = fwflex.StringToFrameworkValuable[ipProtocol](ctx, output.IpProtocol)
 	data.PrefixListID = fwflex.StringToFramework(ctx, output.PrefixListId)
@@ -431,20 +433,20 @@ func flattenReferencedSecurityGroup(ctx context.Context, apiObject *awstypes.Ref
 }
 
 type securityGroupRuleResourceModel struct {
-	ARN                       types.String `tfsdk:"arn"`
-	CIDRIPv4                  types.String `tfsdk:"cidr_ipv4"`
-	CIDRIPv6                  types.String `tfsdk:"cidr_ipv6"`
-	Description               types.String `tfsdk:"description"`
-	FromPort                  types.Int64  `tfsdk:"from_port"`
-	ID                        types.String `tfsdk:"id"`
-	IPProtocol                ipProtocol   `tfsdk:"ip_protocol"`
-	PrefixListID              types.String `tfsdk:"prefix_list_id"`
-	ReferencedSecurityGroupID types.String `tfsdk:"referenced_security_group_id"`
-	SecurityGroupID           types.String `tfsdk:"security_group_id"`
-	SecurityGroupRuleID       types.String `tfsdk:"security_group_rule_id"`
-	Tags                      tftags.Map   `tfsdk:"tags"`
-	TagsAll                   tftags.Map   `tfsdk:"tags_all"`
-	ToPort                    types.Int64  `tfsdk:"to_port"`
+	ARN                       types.String      `tfsdk:"arn"`
+	CIDRIPv4                  fwtypes.CIDRBlock `tfsdk:"cidr_ipv4"`
+	CIDRIPv6                  fwtypes.CIDRBlock `tfsdk:"cidr_ipv6"`
+	Description               types.String      `tfsdk:"description"`
+	FromPort                  types.Int64       `tfsdk:"from_port"`
+	ID                        types.String      `tfsdk:"id"`
+	IPProtocol                ipProtocol        `tfsdk:"ip_protocol"`
+	PrefixListID              types.String      `tfsdk:"prefix_list_id"`
+	ReferencedSecurityGroupID types.String      `tfsdk:"referenced_security_group_id"`
+	SecurityGroupID           types.String      `tfsdk:"security_group_id"`
+	SecurityGroupRuleID       types.String      `tfsdk:"security_group_rule_id"`
+	Tags                      tftags.Map        `tfsdk:"tags"`
+	TagsAll                   tftags.Map        `tfsdk:"tags_all"`
+	ToPort                    types.Int64       `tfsdk:"to_port"`
 }
 
 func (model *securityGroupRuleResourceModel) InitFromID() error {
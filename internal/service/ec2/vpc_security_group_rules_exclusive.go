@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules_exclusive", name="Security Group Rules Exclusive")
+func newResourceSecurityGroupRulesExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSecurityGroupRulesExclusive{}, nil
+}
+
+const (
+	ResNameSecurityGroupRulesExclusive = "Security Group Rules Exclusive"
+)
+
+type resourceSecurityGroupRulesExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_vpc_security_group_rules_exclusive"
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"egress_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"ingress_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceSecurityGroupRulesExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ingressRuleIDs, egressRuleIDs []string
+	resp.Diagnostics.Append(plan.IngressRuleIDs.ElementsAs(ctx, &ingressRuleIDs, false)...)
+	resp.Diagnostics.Append(plan.EgressRuleIDs.ElementsAs(ctx, &egressRuleIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncRules(ctx, plan.SecurityGroupID.ValueString(), ingressRuleIDs, egressRuleIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameSecurityGroupRulesExclusive, plan.SecurityGroupID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().EC2Client(ctx)
+
+	var state resourceSecurityGroupRulesExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ingressRuleIDs, egressRuleIDs, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, state.SecurityGroupID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameSecurityGroupRulesExclusive, state.SecurityGroupID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.IngressRuleIDs = flex.FlattenFrameworkStringValueSetLegacy(ctx, ingressRuleIDs)
+	state.EgressRuleIDs = flex.FlattenFrameworkStringValueSetLegacy(ctx, egressRuleIDs)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceSecurityGroupRulesExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.IngressRuleIDs.Equal(state.IngressRuleIDs) || !plan.EgressRuleIDs.Equal(state.EgressRuleIDs) {
+		var ingressRuleIDs, egressRuleIDs []string
+		resp.Diagnostics.Append(plan.IngressRuleIDs.ElementsAs(ctx, &ingressRuleIDs, false)...)
+		resp.Diagnostics.Append(plan.EgressRuleIDs.ElementsAs(ctx, &egressRuleIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncRules(ctx, plan.SecurityGroupID.ValueString(), ingressRuleIDs, egressRuleIDs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EC2, create.ErrActionUpdating, ResNameSecurityGroupRulesExclusive, plan.SecurityGroupID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncRules handles keeping the configured security group rules
+// in sync with the remote resource.
+//
+// Rules in the security group but not configured on this resource
+// will be revoked. Rules are created by other resources, so configured
+// rules missing from the security group are an error.
+func (r *resourceSecurityGroupRulesExclusive) syncRules(ctx context.Context, groupID string, wantIngress, wantEgress []string) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	haveIngress, haveEgress, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, groupID)
+	if err != nil {
+		return err
+	}
+
+	ingress := framework.ExclusiveMembers[string]{
+		Have: haveIngress,
+		Want: wantIngress,
+		Remove: func(ctx context.Context, id string) error {
+			_, err := conn.RevokeSecurityGroupIngress(ctx, &ec2.RevokeSecurityGroupIngressInput{
+				GroupId:              aws.String(groupID),
+				SecurityGroupRuleIds: []string{id},
+			})
+
+			return err
+		},
+	}
+	if err := ingress.Sync(ctx); err != nil {
+		return err
+	}
+
+	egress := framework.ExclusiveMembers[string]{
+		Have: haveEgress,
+		Want: wantEgress,
+		Remove: func(ctx context.Context, id string) error {
+			_, err := conn.RevokeSecurityGroupEgress(ctx, &ec2.RevokeSecurityGroupEgressInput{
+				GroupId:              aws.String(groupID),
+				SecurityGroupRuleIds: []string{id},
+			})
+
+			return err
+		},
+	}
+
+	return egress.Sync(ctx)
+}
+
+func (r *resourceSecurityGroupRulesExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("security_group_id"), req, resp)
+}
+
+func findSecurityGroupRuleIDsBySecurityGroupID(ctx context.Context, conn *ec2.Client, id string) ([]string, []string, error) {
+	// Filtering rules by group ID returns no rules, rather than an error, for a non-existent group.
+	if _, err := findSecurityGroupByID(ctx, conn, id); err != nil {
+		return nil, nil, err
+	}
+
+	rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ingress, egress []string
+	for _, v := range rules {
+		if aws.ToBool(v.IsEgress) {
+			egress = append(egress, aws.ToString(v.SecurityGroupRuleId))
+		} else {
+			ingress = append(ingress, aws.ToString(v.SecurityGroupRuleId))
+		}
+	}
+
+	return ingress, egress, nil
+}
+
+type resourceSecurityGroupRulesExclusiveData struct {
+	EgressRuleIDs   types.Set    `tfsdk:"egress_rule_ids"`
+	IngressRuleIDs  types.Set    `tfsdk:"ingress_rule_ids"`
+	SecurityGroupID types.String `tfsdk:"security_group_id"`
+}
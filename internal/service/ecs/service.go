@@ -942,7 +942,7 @@ func resourceService() *schema.Resource {
 												names.AttrRoleARN: {
 													Type:         schema.TypeString,
 													Optional:     true,
-													ValidateFunc: verify.ValidARN,
+													ValidateFunc: verify.ValidIAMRoleARN,
 												},
 											},
 										},
@@ -1038,7 +1038,7 @@ func resourceService() *schema.Resource {
 									names.AttrRoleARN: {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidIAMRoleARN,
 									},
 									"size_in_gb": {
 										Type:     schema.TypeInt,
@@ -1096,7 +1096,7 @@ func resourceService() *schema.Resource {
 						names.AttrRoleARN: {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidIAMRoleARN,
 						},
 						"target_group_arn": {
 							Type:         schema.TypeString,
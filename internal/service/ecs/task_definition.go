@@ -141,7 +141,7 @@ func resourceTaskDefinition() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			names.AttrFamily: {
 				Type:     schema.TypeString,
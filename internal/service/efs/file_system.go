@@ -81,7 +81,7 @@ func resourceFileSystem() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			"lifecycle_policy": {
 				Type:     schema.TypeList,
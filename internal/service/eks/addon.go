@@ -98,7 +98,7 @@ func resourceAddon() *schema.Resource {
 						names.AttrRoleARN: {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidIAMRoleARN,
 						},
 						"service_account": {
 							Type:     schema.TypeString,
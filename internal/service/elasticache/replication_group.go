@@ -57,6 +57,10 @@ func resourceReplicationGroup() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("auth_token"), cty.GetAttrPath("auth_token_wo")),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrApplyImmediately: {
 				Type:     schema.TypeBool,
@@ -79,7 +83,7 @@ func resourceReplicationGroup() *schema.Resource {
 				Optional:      true,
 				Sensitive:     true,
 				ValidateFunc:  validReplicationGroupAuthToken,
-				ConflictsWith: []string{"user_group_ids"},
+				ConflictsWith: []string{"auth_token_wo", "user_group_ids"},
 			},
 			"auth_token_update_strategy": {
 				Type:             schema.TypeString,
@@ -87,6 +91,19 @@ func resourceReplicationGroup() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[awstypes.AuthTokenUpdateStrategyType](),
 				Default:          awstypes.AuthTokenUpdateStrategyTypeRotate,
 			},
+			"auth_token_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ValidateFunc:  validReplicationGroupAuthToken,
+				ConflictsWith: []string{"auth_token", "user_group_ids"},
+			},
+			"auth_token_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"auth_token_wo"},
+			},
 			names.AttrAutoMinorVersionUpgrade: {
 				Type:         nullable.TypeNullableBool,
 				Optional:     true,
@@ -367,7 +384,7 @@ func resourceReplicationGroup() *schema.Resource {
 				Type:          schema.TypeSet,
 				Optional:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
-				ConflictsWith: []string{"auth_token"},
+				ConflictsWith: []string{"auth_token", "auth_token_wo"},
 			},
 		},
 
@@ -430,6 +447,13 @@ func resourceReplicationGroupCreate(ctx context.Context, d *schema.ResourceData,
 	conn := meta.(*conns.AWSClient).ElastiCacheClient(ctx)
 	partition := meta.(*conns.AWSClient).Partition(ctx)
 
+	// get write-only value from configuration
+	authTokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("auth_token_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
 	replicationGroupID := d.Get("replication_group_id").(string)
 	input := &elasticache.CreateReplicationGroupInput{
 		ReplicationGroupId: aws.String(replicationGroupID),
@@ -446,6 +470,10 @@ func resourceReplicationGroupCreate(ctx context.Context, d *schema.ResourceData,
 		input.AuthToken = aws.String(v.(string))
 	}
 
+	if authTokenWO != "" {
+		input.AuthToken = aws.String(authTokenWO)
+	}
+
 	if v, ok := d.GetOk(names.AttrAutoMinorVersionUpgrade); ok {
 		if v, null, _ := nullable.Bool(v.(string)).ValueBool(); !null {
 			input.AutoMinorVersionUpgrade = aws.Bool(v)
@@ -986,7 +1014,7 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			}
 		}
 
-		if d.HasChanges("auth_token", "auth_token_update_strategy") {
+		if d.HasChanges("auth_token", "auth_token_wo_version", "auth_token_update_strategy") {
 			input := &elasticache.ModifyReplicationGroupInput{
 				ApplyImmediately:        aws.Bool(true),
 				AuthToken:               aws.String(d.Get("auth_token").(string)),
@@ -994,6 +1022,16 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 				ReplicationGroupId:      aws.String(d.Id()),
 			}
 
+			authTokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("auth_token_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if authTokenWO != "" {
+				input.AuthToken = aws.String(authTokenWO)
+			}
+
 			// tagging may cause this resource to not yet be available, so wait for it to be available
 			const (
 				delay = 0 * time.Second
@@ -162,7 +162,7 @@ func resourceJobTemplate() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidIAMRoleARN,
 						},
 						"job_driver": {
 							Type:     schema.TypeList,
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emrserverless
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless"
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_emrserverless_application", name="Application")
+// @Tags(identifierAttribute="arn")
+func dataSourceApplication() *schema.Resource {
+	resourceSchema := resourceApplication().Schema
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceApplicationRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrApplicationID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{names.AttrApplicationID, names.AttrName},
+			},
+			"architecture": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_start_configuration":     sdkv2.DataSourcePropertyFromResourceProperty(resourceSchema["auto_start_configuration"]),
+			"auto_stop_configuration":      sdkv2.DataSourcePropertyFromResourceProperty(resourceSchema["auto_stop_configuration"]),
+			"image_configuration":          sdkv2.DataSourcePropertyFromResourceProperty(resourceSchema["image_configuration"]),
+			"initial_capacity":             sdkv2.DataSourcePropertyFromResourceProperty(resourceSchema["initial_capacity"]),
+			"interactive_configuration":    sdkv2.DataSourcePropertyFromResourceProperty(resourceSchema["interactive_configuration"]),
+			"maximum_capacity":             sdkv2.DataSourcePropertyFromResourceProperty(resourceSchema["maximum_capacity"]),
+			names.AttrNetworkConfiguration: sdkv2.DataSourcePropertyFromResourceProperty(resourceSchema[names.AttrNetworkConfiguration]),
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{names.AttrApplicationID, names.AttrName},
+			},
+			"release_label": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			names.AttrType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRServerlessClient(ctx)
+
+	applicationID := d.Get(names.AttrApplicationID).(string)
+	if v, ok := d.GetOk(names.AttrName); ok {
+		name := v.(string)
+		summary, err := findApplicationSummary(ctx, conn, func(v *types.ApplicationSummary) bool {
+			return aws.ToString(v.Name) == name
+		})
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EMR Serverless Application", err))
+		}
+
+		applicationID = aws.ToString(summary.Id)
+	}
+
+	application, err := findApplicationByID(ctx, conn, applicationID)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EMR Serverless Application", err))
+	}
+
+	d.SetId(aws.ToString(application.ApplicationId))
+	d.Set(names.AttrApplicationID, application.ApplicationId)
+	d.Set("architecture", application.Architecture)
+	d.Set(names.AttrARN, application.Arn)
+	d.Set(names.AttrName, application.Name)
+	d.Set("release_label", application.ReleaseLabel)
+	d.Set(names.AttrState, application.State)
+	d.Set(names.AttrType, strings.ToLower(aws.ToString(application.Type)))
+
+	if err := d.Set("auto_start_configuration", []interface{}{flattenAutoStartConfig(application.AutoStartConfiguration)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting auto_start_configuration: %s", err)
+	}
+
+	if err := d.Set("auto_stop_configuration", []interface{}{flattenAutoStopConfig(application.AutoStopConfiguration)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting auto_stop_configuration: %s", err)
+	}
+
+	if err := d.Set("image_configuration", flattenImageConfiguration(application.ImageConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting image_configuration: %s", err)
+	}
+
+	if err := d.Set("initial_capacity", flattenInitialCapacity(application.InitialCapacity)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting initial_capacity: %s", err)
+	}
+
+	if err := d.Set("interactive_configuration", []interface{}{flattenInteractiveConfiguration(application.InteractiveConfiguration)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting interactive_configuration: %s", err)
+	}
+
+	if err := d.Set("maximum_capacity", []interface{}{flattenMaximumCapacity(application.MaximumCapacity)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting maximum_capacity: %s", err)
+	}
+
+	if err := d.Set(names.AttrNetworkConfiguration, []interface{}{flattenNetworkConfiguration(application.NetworkConfiguration)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting network_configuration: %s", err)
+	}
+
+	setTagsOut(ctx, application.Tags)
+
+	return diags
+}
+
+func findApplicationSummary(ctx context.Context, conn *emrserverless.Client, filter tfslices.Predicate[*types.ApplicationSummary]) (*types.ApplicationSummary, error) {
+	// Terminated applications remain visible for a period after deletion.
+	input := &emrserverless.ListApplicationsInput{
+		States: tfslices.Filter(enum.EnumValues[types.ApplicationState](), func(v types.ApplicationState) bool {
+			return v != types.ApplicationStateTerminated
+		}),
+	}
+
+	output, err := findApplicationSummaries(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findApplicationSummaries(ctx context.Context, conn *emrserverless.Client, input *emrserverless.ListApplicationsInput, filter tfslices.Predicate[*types.ApplicationSummary]) ([]types.ApplicationSummary, error) {
+	var output []types.ApplicationSummary
+
+	pages := emrserverless.NewListApplicationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Applications {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emrserverless_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEMRServerlessApplicationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_emrserverless_application.test"
+	resourceName := "aws_emrserverless_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationDataSourceConfig_byID(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrApplicationID, resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "architecture", resourceName, "architecture"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "auto_start_configuration.#", resourceName, "auto_start_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "auto_stop_configuration.0.idle_timeout_minutes", resourceName, "auto_stop_configuration.0.idle_timeout_minutes"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "maximum_capacity.#", resourceName, "maximum_capacity.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "release_label", resourceName, "release_label"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrState, "CREATED"),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.key1", resourceName, "tags.key1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrType, resourceName, names.AttrType),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEMRServerlessApplicationDataSource_name(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_emrserverless_application.test"
+	resourceName := "aws_emrserverless_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationDataSourceConfig_byName(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrApplicationID, resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_emrserverless_application" "test" {
+  name          = %[1]q
+  release_label = "emr-6.6.0"
+  type          = "hive"
+
+  tags = {
+    key1 = "value1"
+  }
+}
+`, rName)
+}
+
+func testAccApplicationDataSourceConfig_byID(rName string) string {
+	return acctest.ConfigCompose(testAccApplicationDataSourceConfig_base(rName), `
+data "aws_emrserverless_application" "test" {
+  application_id = aws_emrserverless_application.test.id
+}
+`)
+}
+
+func testAccApplicationDataSourceConfig_byName(rName string) string {
+	return acctest.ConfigCompose(testAccApplicationDataSourceConfig_base(rName), `
+data "aws_emrserverless_application" "test" {
+  name = aws_emrserverless_application.test.name
+}
+`)
+}
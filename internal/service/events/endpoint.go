@@ -92,7 +92,7 @@ func resourceEndpoint() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"routing_config": {
 				Type:     schema.TypeList,
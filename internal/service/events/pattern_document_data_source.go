@@ -0,0 +1,423 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/eventpattern"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudwatch_event_pattern_document", name="Pattern Document")
+func dataSourcePatternDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePatternDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"field": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     patternDocumentFieldResource(),
+			},
+			names.AttrJSON: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"or": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     patternDocumentFieldResource(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func patternDocumentFieldResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"anything_but": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"equals_ignore_case": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"numbers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeFloat,
+							},
+						},
+						names.AttrPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"suffix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrValues: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"wildcard": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"cidr": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+			"equals": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"equals_ignore_case": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"exists": {
+				Type:         nullable.TypeNullableBool,
+				Optional:     true,
+				ValidateFunc: nullable.ValidateTypeStringNullableBool,
+			},
+			"numeric": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrCondition: {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"operator": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"=", "<", "<=", ">", ">="}, false),
+									},
+									names.AttrValue: {
+										Type:     schema.TypeFloat,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrPath: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			names.AttrPrefix: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"prefix_ignore_case": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"suffix": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"suffix_ignore_case": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"wildcard": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePatternDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	pattern, err := expandPatternDocumentFields(d.Get("field").([]interface{}))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if v, ok := d.GetOk("or"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		var alternatives []interface{}
+
+		for i, tfMapRaw := range v.([]interface{})[0].(map[string]interface{})["pattern"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			alternative, err := expandPatternDocumentFields(tfMap["field"].([]interface{}))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "or pattern %d: %s", i, err)
+			}
+
+			alternatives = append(alternatives, alternative)
+		}
+
+		pattern["$or"] = alternatives
+	}
+
+	if len(pattern) == 0 {
+		return sdkdiag.AppendErrorf(diags, `at least one "field" or "or" block must be configured`)
+	}
+
+	// Disable HTML escaping so that numeric operators such as "<" are rendered verbatim.
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(pattern); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	jsonString := strings.TrimSuffix(buf.String(), "\n")
+
+	if _, err := eventpattern.Parse(jsonString); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set(names.AttrJSON, jsonString)
+
+	d.SetId(strconv.Itoa(schema.HashString(jsonString)))
+
+	return diags
+}
+
+// expandPatternDocumentFields returns the event pattern object for a list of field blocks.
+// Dot-separated field paths are expanded into nested objects.
+func expandPatternDocumentFields(tfList []interface{}) (map[string]interface{}, error) {
+	pattern := make(map[string]interface{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		path := tfMap[names.AttrPath].(string)
+
+		matchers, err := expandPatternDocumentMatchers(tfMap)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", path, err)
+		}
+
+		object := pattern
+		keys := strings.Split(path, ".")
+		for i, key := range keys {
+			if key == "" {
+				return nil, fmt.Errorf("field %q: path must not contain empty segments", path)
+			}
+
+			if i == len(keys)-1 {
+				if _, ok := object[key]; ok {
+					return nil, fmt.Errorf("field %q: path conflicts with another field", path)
+				}
+
+				object[key] = matchers
+				break
+			}
+
+			switch v := object[key].(type) {
+			case nil:
+				child := make(map[string]interface{})
+				object[key] = child
+				object = child
+			case map[string]interface{}:
+				object = v
+			default:
+				return nil, fmt.Errorf("field %q: path conflicts with another field", path)
+			}
+		}
+	}
+
+	return pattern, nil
+}
+
+func expandPatternDocumentMatchers(tfMap map[string]interface{}) ([]interface{}, error) {
+	var matchers []interface{}
+
+	if v, ok := tfMap["equals"].([]interface{}); ok {
+		for _, v := range flex.ExpandStringValueList(v) {
+			matchers = append(matchers, v)
+		}
+	}
+	if v, ok := tfMap["equals_ignore_case"].([]interface{}); ok {
+		for _, v := range flex.ExpandStringValueList(v) {
+			matchers = append(matchers, map[string]interface{}{"equals-ignore-case": v})
+		}
+	}
+	if v, ok := tfMap[names.AttrPrefix].([]interface{}); ok {
+		for _, v := range flex.ExpandStringValueList(v) {
+			matchers = append(matchers, map[string]interface{}{"prefix": v})
+		}
+	}
+	if v, ok := tfMap["prefix_ignore_case"].([]interface{}); ok {
+		for _, v := range flex.ExpandStringValueList(v) {
+			matchers = append(matchers, map[string]interface{}{"prefix": map[string]interface{}{"equals-ignore-case": v}})
+		}
+	}
+	if v, ok := tfMap["suffix"].([]interface{}); ok {
+		for _, v := range flex.ExpandStringValueList(v) {
+			matchers = append(matchers, map[string]interface{}{"suffix": v})
+		}
+	}
+	if v, ok := tfMap["suffix_ignore_case"].([]interface{}); ok {
+		for _, v := range flex.ExpandStringValueList(v) {
+			matchers = append(matchers, map[string]interface{}{"suffix": map[string]interface{}{"equals-ignore-case": v}})
+		}
+	}
+	if v, ok := tfMap["wildcard"].([]interface{}); ok {
+		for _, v := range flex.ExpandStringValueList(v) {
+			matchers = append(matchers, map[string]interface{}{"wildcard": v})
+		}
+	}
+	if v, ok := tfMap["cidr"].([]interface{}); ok {
+		for _, v := range flex.ExpandStringValueList(v) {
+			matchers = append(matchers, map[string]interface{}{"cidr": v})
+		}
+	}
+	if v, ok := tfMap["numeric"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			var operands []interface{}
+			for _, tfMapRaw := range tfMap[names.AttrCondition].([]interface{}) {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				operands = append(operands, tfMap["operator"].(string), tfMap[names.AttrValue].(float64))
+			}
+
+			matchers = append(matchers, map[string]interface{}{"numeric": operands})
+		}
+	}
+	if v, ok := tfMap["anything_but"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		anythingBut, err := expandPatternDocumentAnythingBut(v[0].(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+
+		matchers = append(matchers, map[string]interface{}{"anything-but": anythingBut})
+	}
+	if v, null, _ := nullable.Bool(tfMap["exists"].(string)).ValueBool(); !null {
+		matchers = append(matchers, map[string]interface{}{"exists": v})
+	}
+
+	if len(matchers) == 0 {
+		return nil, fmt.Errorf("at least one matcher must be configured")
+	}
+
+	return matchers, nil
+}
+
+func expandPatternDocumentAnythingBut(tfMap map[string]interface{}) (interface{}, error) {
+	var apiObjects []interface{}
+
+	if v, ok := tfMap["equals_ignore_case"].([]interface{}); ok && len(v) > 0 {
+		apiObjects = append(apiObjects, map[string]interface{}{"equals-ignore-case": flex.ExpandStringValueList(v)})
+	}
+	if v, ok := tfMap["numbers"].([]interface{}); ok && len(v) > 0 {
+		apiObjects = append(apiObjects, v)
+	}
+	if v, ok := tfMap[names.AttrPrefix].(string); ok && v != "" {
+		apiObjects = append(apiObjects, map[string]interface{}{"prefix": v})
+	}
+	if v, ok := tfMap["suffix"].(string); ok && v != "" {
+		apiObjects = append(apiObjects, map[string]interface{}{"suffix": v})
+	}
+	if v, ok := tfMap[names.AttrValues].([]interface{}); ok && len(v) > 0 {
+		apiObjects = append(apiObjects, flex.ExpandStringValueList(v))
+	}
+	if v, ok := tfMap["wildcard"].([]interface{}); ok && len(v) > 0 {
+		apiObjects = append(apiObjects, map[string]interface{}{"wildcard": flex.ExpandStringValueList(v)})
+	}
+
+	if len(apiObjects) != 1 {
+		return nil, fmt.Errorf(`exactly one of "equals_ignore_case", "numbers", "prefix", "suffix", "values" or "wildcard" must be configured in "anything_but"`)
+	}
+
+	return apiObjects[0], nil
+}
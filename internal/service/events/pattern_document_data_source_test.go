@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEventsPatternDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_event_pattern_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, `{
+  "source": ["aws.ec2"],
+  "detail-type": ["EC2 Instance State-change Notification"],
+  "detail": {
+    "state": [{"anything-but": ["pending", "running"]}]
+  }
+}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternDocumentDataSource_matchers(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_event_pattern_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternDocumentDataSourceConfig_matchers,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, `{
+  "source": [{"prefix": "custom."}, {"prefix": {"equals-ignore-case": "app."}}],
+  "detail": {
+    "file": [{"suffix": ".png"}, {"suffix": {"equals-ignore-case": ".jpg"}}],
+    "bucket": [{"wildcard": "logs-*"}],
+    "region": [{"equals-ignore-case": "US-WEST-2"}],
+    "source-ip": [{"cidr": "10.0.0.0/16"}],
+    "size": [{"numeric": [">", 0, "<=", 1024]}, {"numeric": ["=", 2048]}],
+    "status": [{"anything-but": {"prefix": "error-"}}],
+    "error": [{"exists": false}]
+  }
+}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternDocumentDataSource_or(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_event_pattern_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternDocumentDataSourceConfig_or,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, `{
+  "source": ["aws.ec2"],
+  "$or": [
+    {"detail": {"state": ["stopped"]}},
+    {"detail": {"cpu": [{"numeric": [">=", 90]}]}}
+  ]
+}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternDocumentDataSource_rule(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_event_pattern_document.test"
+	resourceName := "aws_cloudwatch_event_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternDocumentDataSourceConfig_rule(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "event_pattern", dataSourceName, names.AttrJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternDocumentDataSource_pathConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPatternDocumentDataSourceConfig_pathConflict,
+				ExpectError: regexache.MustCompile(`field "detail.state": path conflicts with another field`),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternDocumentDataSource_noMatchers(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPatternDocumentDataSourceConfig_noMatchers,
+				ExpectError: regexache.MustCompile(`field "source": at least one matcher must be configured`),
+			},
+		},
+	})
+}
+
+const testAccPatternDocumentDataSourceConfig_basic = `
+data "aws_cloudwatch_event_pattern_document" "test" {
+  field {
+    path   = "source"
+    equals = ["aws.ec2"]
+  }
+
+  field {
+    path   = "detail-type"
+    equals = ["EC2 Instance State-change Notification"]
+  }
+
+  field {
+    path = "detail.state"
+
+    anything_but {
+      values = ["pending", "running"]
+    }
+  }
+}
+`
+
+const testAccPatternDocumentDataSourceConfig_matchers = `
+data "aws_cloudwatch_event_pattern_document" "test" {
+  field {
+    path               = "source"
+    prefix             = ["custom."]
+    prefix_ignore_case = ["app."]
+  }
+
+  field {
+    path               = "detail.file"
+    suffix             = [".png"]
+    suffix_ignore_case = [".jpg"]
+  }
+
+  field {
+    path     = "detail.bucket"
+    wildcard = ["logs-*"]
+  }
+
+  field {
+    path               = "detail.region"
+    equals_ignore_case = ["US-WEST-2"]
+  }
+
+  field {
+    path = "detail.source-ip"
+    cidr = ["10.0.0.0/16"]
+  }
+
+  field {
+    path = "detail.size"
+
+    numeric {
+      condition {
+        operator = ">"
+        value    = 0
+      }
+
+      condition {
+        operator = "<="
+        value    = 1024
+      }
+    }
+
+    numeric {
+      condition {
+        operator = "="
+        value    = 2048
+      }
+    }
+  }
+
+  field {
+    path = "detail.status"
+
+    anything_but {
+      prefix = "error-"
+    }
+  }
+
+  field {
+    path   = "detail.error"
+    exists = false
+  }
+}
+`
+
+const testAccPatternDocumentDataSourceConfig_or = `
+data "aws_cloudwatch_event_pattern_document" "test" {
+  field {
+    path   = "source"
+    equals = ["aws.ec2"]
+  }
+
+  or {
+    pattern {
+      field {
+        path   = "detail.state"
+        equals = ["stopped"]
+      }
+    }
+
+    pattern {
+      field {
+        path = "detail.cpu"
+
+        numeric {
+          condition {
+            operator = ">="
+            value    = 90
+          }
+        }
+      }
+    }
+  }
+}
+`
+
+func testAccPatternDocumentDataSourceConfig_rule(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_event_pattern_document" "test" {
+  field {
+    path   = "source"
+    equals = ["aws.ec2"]
+  }
+
+  field {
+    path = "detail.state"
+
+    anything_but {
+      values = ["running"]
+    }
+  }
+}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name          = %[1]q
+  event_pattern = data.aws_cloudwatch_event_pattern_document.test.json
+}
+`, rName)
+}
+
+const testAccPatternDocumentDataSourceConfig_pathConflict = `
+data "aws_cloudwatch_event_pattern_document" "test" {
+  field {
+    path   = "detail"
+    exists = true
+  }
+
+  field {
+    path   = "detail.state"
+    equals = ["running"]
+  }
+}
+`
+
+const testAccPatternDocumentDataSourceConfig_noMatchers = `
+data "aws_cloudwatch_event_pattern_document" "test" {
+  field {
+    path = "source"
+  }
+}
+`
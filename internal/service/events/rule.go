@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -71,10 +72,11 @@ func resourceRule() *schema.Resource {
 				Default:      DefaultEventBusName,
 			},
 			"event_pattern": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validateEventPatternValue(),
-				AtLeastOneOf: []string{names.AttrScheduleExpression, "event_pattern"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateEventPatternValue(),
+				AtLeastOneOf:     []string{names.AttrScheduleExpression, "event_pattern"},
+				DiffSuppressFunc: verify.SuppressEquivalentEventPatternDiffs,
 				StateFunc: func(v interface{}) string {
 					json, _ := ruleEventPatternJSONDecoder(v.(string))
 					return json
@@ -117,12 +119,15 @@ func resourceRule() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			names.AttrScheduleExpression: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringLenBetween(0, 256),
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 256),
+					verify.ValidScheduleExpression(schedule.KindCron, schedule.KindRate),
+				),
 				AtLeastOneOf: []string{names.AttrScheduleExpression, "event_pattern"},
 			},
 			names.AttrState: {
@@ -40,6 +40,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_cloudwatch_event_connection",
 			Name:     "Connection",
 		},
+		{
+			Factory:  dataSourcePatternDocument,
+			TypeName: "aws_cloudwatch_event_pattern_document",
+			Name:     "Pattern Document",
+		},
 		{
 			Factory:  dataSourceSource,
 			TypeName: "aws_cloudwatch_event_source",
@@ -96,7 +96,7 @@ func ResourceKxEnvironment() *schema.Resource {
 			names.AttrKMSKeyID: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			"last_modified_timestamp": {
 				Type:     schema.TypeString,
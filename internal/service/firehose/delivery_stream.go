@@ -263,7 +263,7 @@ func resourceDeliveryStream() *schema.Resource {
 						"bucket_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidS3BucketARN,
 						},
 						"buffering_interval": {
 							Type:     schema.TypeInt,
@@ -300,7 +300,7 @@ func resourceDeliveryStream() *schema.Resource {
 						names.AttrRoleARN: {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidIAMRoleARN,
 						},
 					},
 				}
@@ -343,7 +343,7 @@ func resourceDeliveryStream() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Optional:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 						},
 					},
@@ -421,7 +421,7 @@ func resourceDeliveryStream() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							"s3_backup_mode": {
 								Type:             schema.TypeString,
@@ -447,7 +447,7 @@ func resourceDeliveryStream() *schema.Resource {
 											Type:         schema.TypeString,
 											Required:     true,
 											ForceNew:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 										names.AttrSecurityGroupIDs: {
 											Type:     schema.TypeSet,
@@ -480,7 +480,7 @@ func resourceDeliveryStream() *schema.Resource {
 							"bucket_arn": {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidS3BucketARN,
 							},
 							"buffering_interval": {
 								Type:     schema.TypeInt,
@@ -732,7 +732,7 @@ func resourceDeliveryStream() *schema.Resource {
 													names.AttrRoleARN: {
 														Type:         schema.TypeString,
 														Required:     true,
-														ValidateFunc: verify.ValidARN,
+														ValidateFunc: verify.ValidIAMRoleARN,
 													},
 													names.AttrTableName: {
 														Type:     schema.TypeString,
@@ -776,7 +776,7 @@ func resourceDeliveryStream() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							"s3_backup_configuration": s3BackupConfigurationSchema(),
 							"s3_backup_mode": {
@@ -831,7 +831,7 @@ func resourceDeliveryStream() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Optional:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							"s3_backup_mode": {
 								Type:             schema.TypeString,
@@ -886,7 +886,7 @@ func resourceDeliveryStream() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							"s3_backup_mode": {
 								Type:             schema.TypeString,
@@ -916,7 +916,7 @@ func resourceDeliveryStream() *schema.Resource {
 								Type:         schema.TypeString,
 								Required:     true,
 								ForceNew:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 						},
 					},
@@ -946,7 +946,7 @@ func resourceDeliveryStream() *schema.Resource {
 											Type:         schema.TypeString,
 											Required:     true,
 											ForceNew:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 									},
 								},
@@ -1035,7 +1035,7 @@ func resourceDeliveryStream() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							"s3_backup_mode": {
 								Type:             schema.TypeString,
@@ -1061,7 +1061,7 @@ func resourceDeliveryStream() *schema.Resource {
 											Type:         schema.TypeString,
 											Required:     true,
 											ForceNew:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 										names.AttrSecurityGroupIDs: {
 											Type:     schema.TypeSet,
@@ -1122,7 +1122,7 @@ func resourceDeliveryStream() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							"s3_backup_mode": {
 								Type:             schema.TypeString,
@@ -1143,7 +1143,7 @@ func resourceDeliveryStream() *schema.Resource {
 											Type:         schema.TypeString,
 											Required:     true,
 											ForceNew:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 										names.AttrSecurityGroupIDs: {
 											Type:     schema.TypeSet,
@@ -1205,7 +1205,7 @@ func resourceDeliveryStream() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							"s3_backup_configuration": s3BackupConfigurationSchema(),
 							"s3_backup_mode": {
@@ -1317,7 +1317,7 @@ func resourceDeliveryStream() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							"s3_backup_mode": {
 								Type:             schema.TypeString,
@@ -193,7 +193,7 @@ func resourceExperimentTemplate() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"stop_condition": {
 				Type:     schema.TypeSet,
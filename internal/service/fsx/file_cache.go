@@ -179,7 +179,7 @@ func resourceFileCache() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			"lustre_configuration": {
 				Type:     schema.TypeSet,
@@ -165,7 +165,7 @@ func resourceLustreFileSystem() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			"log_configuration": {
 				Type:     schema.TypeList,
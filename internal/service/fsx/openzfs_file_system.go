@@ -143,7 +143,7 @@ func resourceOpenZFSFileSystem() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			"network_interface_ids": {
 				Type:     schema.TypeList,
@@ -173,7 +173,7 @@ func resourceWindowsFileSystem() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			"network_interface_ids": {
 				Type:     schema.TypeSet,
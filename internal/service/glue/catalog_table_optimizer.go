@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -95,6 +96,9 @@ func (r *resourceCatalogTableOptimizer) Schema(ctx context.Context, _ resource.S
 						names.AttrRoleARN: schema.StringAttribute{
 							CustomType: fwtypes.ARNType,
 							Required:   true,
+							Validators: []validator.String{
+								fwvalidators.IAMRoleARN(),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -151,7 +151,7 @@ func resourceWorkspace() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"saml_configuration_status": {
 				Type:     schema.TypeString,
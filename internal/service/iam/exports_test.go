@@ -57,5 +57,7 @@ var (
 	FindUserPoliciesByName              = findUserPoliciesByName
 	FindUserPolicyAttachmentsByName     = findUserPolicyAttachmentsByName
 	FindVirtualMFADeviceBySerialNumber  = findVirtualMFADeviceBySerialNumber
+	LintPolicyDocument                  = lintPolicyDocument
 	SESSMTPPasswordFromSecretKeySigV4   = sesSMTPPasswordFromSecretKeySigV4
+	ValidPolicyJSON                     = validPolicyJSON
 )
@@ -5,6 +5,7 @@
 //go:generate go run ../../generate/tags/main.go -ServiceTagsSlice
 //go:generate go run ../../generate/servicepackage/main.go
 //go:generate go run ../../generate/tagstests/main.go
+//go:generate go run ../../generate/serviceauthorization/main.go -Services=dynamodb,ec2,iam,kms,lambda,logs,s3,secretsmanager,sns,sqs,sts
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package iam
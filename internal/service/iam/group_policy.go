@@ -60,7 +60,7 @@ func resourceGroupPolicy() *schema.Resource {
 			names.AttrPolicy: {
 				Type:                  schema.TypeString,
 				Required:              true,
-				ValidateFunc:          verify.ValidIAMPolicyJSON,
+				ValidateFunc:          validPolicyJSON,
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
 				StateFunc: func(v interface{}) string {
@@ -69,6 +69,8 @@ func resourceGroupPolicy() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: identityPolicyValidation.CustomizeDiff,
 	}
 }
 
@@ -107,6 +109,8 @@ func resourceGroupPolicyPut(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	diags = append(diags, identityPolicyValidation.Warnings(ctx, d, meta)...)
+
 	return append(diags, resourceGroupPolicyRead(ctx, d, meta)...)
 }
 
@@ -325,6 +325,10 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 
 	d.Set("minified_json", jsonMinString)
 
+	for _, finding := range lintPolicyDocument(mergedDoc) {
+		diags = sdkdiag.AppendWarningf(diags, "IAM Policy Document: %s", finding)
+	}
+
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return diags
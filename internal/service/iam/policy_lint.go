@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+//go:embed service_authorization_gen.json
+var serviceAuthorizationJSON []byte
+
+// serviceAuthorization is a service's actions, resource ARN formats and condition keys from the AWS Service Authorization Reference.
+type serviceAuthorization struct {
+	Actions       []string `json:"actions"`
+	ARNFormats    []string `json:"arnFormats"`
+	ConditionKeys []string `json:"conditionKeys"`
+}
+
+// serviceAuthorizations returns the embedded service authorization catalog, keyed by service prefix.
+var serviceAuthorizations = sync.OnceValue(func() map[string]serviceAuthorization {
+	var catalog map[string]serviceAuthorization
+	if err := json.Unmarshal(serviceAuthorizationJSON, &catalog); err != nil {
+		panic(fmt.Sprintf("decoding service authorization catalog: %s", err))
+	}
+
+	return catalog
+})
+
+// globalConditionKeys are the condition keys available in all services.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_condition-keys.html.
+var globalConditionKeys = []string{
+	"aws:AssumedRoot",
+	"aws:CalledVia",
+	"aws:CalledViaFirst",
+	"aws:CalledViaLast",
+	"aws:CurrentTime",
+	"aws:Ec2InstanceSourcePrivateIPv4",
+	"aws:Ec2InstanceSourceVpc",
+	"aws:EpochTime",
+	"aws:FederatedProvider",
+	"aws:MultiFactorAuthAge",
+	"aws:MultiFactorAuthPresent",
+	"aws:PrincipalAccount",
+	"aws:PrincipalArn",
+	"aws:PrincipalIsAWSService",
+	"aws:PrincipalOrgID",
+	"aws:PrincipalOrgPaths",
+	"aws:PrincipalServiceName",
+	"aws:PrincipalServiceNamesList",
+	"aws:PrincipalTag/${TagKey}",
+	"aws:PrincipalType",
+	"aws:Referer",
+	"aws:RequestTag/${TagKey}",
+	"aws:RequestedRegion",
+	"aws:ResourceAccount",
+	"aws:ResourceOrgID",
+	"aws:ResourceOrgPaths",
+	"aws:ResourceTag/${TagKey}",
+	"aws:SecureTransport",
+	"aws:SourceAccount",
+	"aws:SourceArn",
+	"aws:SourceIdentity",
+	"aws:SourceIp",
+	"aws:SourceOrgID",
+	"aws:SourceOrgPaths",
+	"aws:SourceVpc",
+	"aws:SourceVpcArn",
+	"aws:SourceVpce",
+	"aws:TagKeys",
+	"aws:TokenIssueTime",
+	"aws:UserAgent",
+	"aws:ViaAWSService",
+	"aws:VpcSourceIp",
+	"aws:VpceAccount",
+	"aws:VpceOrgID",
+	"aws:VpceOrgPaths",
+	"aws:userid",
+	"aws:username",
+}
+
+// validPolicyJSON is verify.ValidIAMPolicyJSON, additionally returning any policy linting findings as warnings.
+func validPolicyJSON(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = verify.ValidIAMPolicyJSON(v, k)
+	if len(errors) > 0 {
+		return ws, errors
+	}
+
+	var doc IAMPolicyDoc
+	if err := json.Unmarshal([]byte(v.(string)), &doc); err != nil {
+		// Policies that the model cannot represent, e.g. with a single Statement object, are not linted.
+		return ws, errors
+	}
+
+	for _, finding := range lintPolicyDocument(&doc) {
+		ws = append(ws, fmt.Sprintf("%q: %s", k, finding))
+	}
+
+	return ws, errors
+}
+
+// lintPolicyDocument returns findings for actions, resource ARNs and condition keys that are not in the service authorization catalog,
+// and for statements that are overly permissive.
+// Only services in the catalog are checked.
+func lintPolicyDocument(doc *IAMPolicyDoc) []string {
+	var findings []string
+
+	for i, statement := range doc.Statements {
+		if statement == nil {
+			continue
+		}
+
+		name := fmt.Sprintf("statement %d", i)
+		if statement.Sid != "" {
+			name = fmt.Sprintf("statement %q", statement.Sid)
+		}
+		appendFinding := func(format string, a ...any) {
+			findings = append(findings, name+": "+fmt.Sprintf(format, a...))
+		}
+
+		actions, notActions := policyStatementStrings(statement.Actions), policyStatementStrings(statement.NotActions)
+		resources, notResources := policyStatementStrings(statement.Resources), policyStatementStrings(statement.NotResources)
+
+		for _, v := range slices.Concat(actions, notActions) {
+			if finding := lintPolicyAction(v); finding != "" {
+				appendFinding("%s", finding)
+			}
+		}
+
+		for _, v := range slices.Concat(resources, notResources) {
+			if finding := lintPolicyResource(v); finding != "" {
+				appendFinding("%s", finding)
+			}
+		}
+
+		for _, v := range statement.Conditions {
+			if finding := lintPolicyConditionKey(v.Variable); finding != "" {
+				appendFinding("%s", finding)
+			}
+		}
+
+		if statement.Effect == "Allow" {
+			if slices.ContainsFunc(actions, func(v string) bool { return v == "*" || v == "*:*" }) && slices.Contains(resources, "*") {
+				appendFinding("allows all actions on all resources")
+			}
+
+			if len(notActions) > 0 {
+				appendFinding("uses NotAction with Allow, which allows all actions that are not listed, including actions added to services in the future")
+			}
+		}
+	}
+
+	return findings
+}
+
+func lintPolicyAction(action string) string {
+	if action == "*" {
+		return ""
+	}
+
+	prefix, name, ok := strings.Cut(action, ":")
+	if !ok || prefix == "" || name == "" {
+		return fmt.Sprintf("action %q is not of the form service:action", action)
+	}
+
+	service, ok := serviceAuthorizations()[strings.ToLower(prefix)]
+	if !ok {
+		return ""
+	}
+
+	// Action names are case-insensitive.
+	name = strings.ToLower(name)
+	if strings.ContainsAny(name, "*?") {
+		if !slices.ContainsFunc(service.Actions, func(v string) bool { return matchPolicyWildcard(name, strings.ToLower(v)) }) {
+			return fmt.Sprintf("action %q does not match any %s action", action, prefix)
+		}
+	} else if !slices.ContainsFunc(service.Actions, func(v string) bool { return strings.ToLower(v) == name }) {
+		return fmt.Sprintf("action %q is not a known %s action", action, prefix)
+	}
+
+	return ""
+}
+
+func lintPolicyResource(resource string) string {
+	if resource == "*" {
+		return ""
+	}
+
+	if !strings.HasPrefix(resource, "arn:") {
+		return fmt.Sprintf("resource %q is not an ARN", resource)
+	}
+
+	// Policy variables can contain ':' and match any value.
+	parts := strings.SplitN(replacePolicyVariables(resource, "*"), ":", 6)
+	if len(parts) < 6 {
+		return fmt.Sprintf("resource %q is not a valid ARN", resource)
+	}
+
+	prefix := parts[2]
+	service, ok := serviceAuthorizations()[prefix]
+	if !ok {
+		return ""
+	}
+
+	var checked bool
+	for _, format := range service.ARNFormats {
+		formatParts := strings.SplitN(format, ":", 6)
+
+		// Services' ARN formats include those of other services' resources, e.g. IAM roles for STS.
+		if len(formatParts) < 6 || formatParts[2] != prefix {
+			continue
+		}
+
+		checked = true
+		if arnMatchesFormat(parts, formatParts) {
+			return ""
+		}
+	}
+
+	if !checked {
+		return ""
+	}
+
+	return fmt.Sprintf("resource %q does not match any %s resource ARN format", resource, prefix)
+}
+
+// arnMatchesFormat returns whether the parts of an ARN, which may contain wildcards, match the parts of an ARN format.
+func arnMatchesFormat(parts, formatParts []string) bool {
+	// Partition, service, Region and account ID.
+	for i := 1; i <= 4; i++ {
+		if strings.Contains(formatParts[i], "${") {
+			if parts[i] == "" {
+				return false
+			}
+		} else if !matchPolicyWildcard(parts[i], formatParts[i]) {
+			return false
+		}
+	}
+
+	// Resource.
+	resource, format := parts[5], formatParts[5]
+	if !strings.Contains(format, "${") {
+		return matchPolicyWildcard(resource, format)
+	}
+
+	if i := strings.IndexAny(resource, "*?"); i >= 0 {
+		// Only the literal prefixes of a resource with wildcards and a format with variables are compared.
+		resourcePrefix, formatPrefix := resource[:i], format[:strings.Index(format, "${")]
+		return strings.HasPrefix(resourcePrefix, formatPrefix) || strings.HasPrefix(formatPrefix, resourcePrefix)
+	}
+
+	return matchPolicyWildcard(replacePolicyVariables(format, "*"), resource)
+}
+
+func lintPolicyConditionKey(key string) string {
+	prefix, _, ok := strings.Cut(key, ":")
+	if !ok || prefix == "" {
+		return fmt.Sprintf("condition key %q is not of the form service:key", key)
+	}
+
+	if prefix = strings.ToLower(prefix); prefix == "aws" {
+		if !slices.ContainsFunc(globalConditionKeys, func(v string) bool { return conditionKeyMatches(key, v) }) {
+			return fmt.Sprintf("condition key %q is not a known global condition key", key)
+		}
+
+		return ""
+	}
+
+	service, ok := serviceAuthorizations()[prefix]
+	if !ok {
+		return ""
+	}
+
+	if !slices.ContainsFunc(service.ConditionKeys, func(v string) bool { return conditionKeyMatches(key, v) }) {
+		return fmt.Sprintf("condition key %q is not a known %s condition key", key, prefix)
+	}
+
+	return ""
+}
+
+// conditionKeyMatches returns whether a condition key matches a catalog condition key, e.g. "aws:RequestTag/${TagKey}".
+// Condition keys are case-insensitive.
+func conditionKeyMatches(key, catalogKey string) bool {
+	if i := strings.Index(catalogKey, "${"); i >= 0 {
+		return len(key) > i && strings.EqualFold(key[:i], catalogKey[:i])
+	}
+
+	return strings.EqualFold(key, catalogKey)
+}
+
+// replacePolicyVariables replaces policy variables and ARN format variables, e.g. "${aws:username}", with the specified string.
+func replacePolicyVariables(s, replacement string) string {
+	var sb strings.Builder
+
+	for {
+		i := strings.Index(s, "${")
+		if i < 0 {
+			break
+		}
+		j := strings.Index(s[i:], "}")
+		if j < 0 {
+			break
+		}
+
+		sb.WriteString(s[:i])
+		sb.WriteString(replacement)
+		s = s[i+j+1:]
+	}
+	sb.WriteString(s)
+
+	return sb.String()
+}
+
+// matchPolicyWildcard returns whether a string matches a pattern in which '*' matches any sequence of characters and '?' matches any single character.
+func matchPolicyWildcard(pattern, s string) bool {
+	p, i := 0, 0
+	star, match := -1, 0
+
+	for i < len(s) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == s[i]):
+			p++
+			i++
+		case p < len(pattern) && pattern[p] == '*':
+			star, match = p, i
+			p++
+		case star >= 0:
+			p = star + 1
+			match++
+			i = match
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
+
+// policyStatementStrings returns the values of a statement's Action, NotAction, Resource or NotResource element.
+func policyStatementStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		s := make([]string, 0, len(v))
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	default:
+		return nil
+	}
+}
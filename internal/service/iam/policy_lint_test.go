@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestLintPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy   string
+		expected []string
+	}{
+		"valid": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:GetObject", "s3:list*", "SQS:SendMessage", "ec2:DescribeInstances"],
+      "Resource": [
+        "arn:aws:s3:::my-bucket/*",
+        "arn:aws:s3:::my-bucket",
+        "arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point",
+        "arn:aws:sqs:*:123456789012:my-queue",
+        "arn:aws:dynamodb:us-west-2:123456789012:table/my-table/index/*",
+        "arn:aws:lambda:us-west-2:123456789012:function:my-function:prod",
+        "arn:aws:s3:::my-bucket/home/${aws:username}/*",
+        "arn:aws:ec2:us-west-2:123456789012:instance/*"
+      ],
+      "Condition": {
+        "StringEquals": {
+          "aws:PrincipalTag/team": "blue",
+          "AWS:SourceIP": "192.0.2.0/24",
+          "s3:prefix": "home/",
+          "kms:EncryptionContext:aws:s3:arn": "arn:aws:s3:::my-bucket",
+          "ec2:Region": "us-west-2"
+        }
+      }
+    },
+    {
+      "Effect": "Deny",
+      "NotAction": "iam:*",
+      "Resource": "*"
+    }
+  ]
+}`,
+		},
+		"unknown actions": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Objects",
+      "Effect": "Allow",
+      "Action": ["s3:GetObjects", "s3:GetObjekt*", "GetObject"],
+      "Resource": "arn:aws:s3:::my-bucket/*"
+    }
+  ]
+}`,
+			expected: []string{
+				`statement "Objects": action "s3:GetObjects" is not a known s3 action`,
+				`statement "Objects": action "s3:GetObjekt*" does not match any s3 action`,
+				`statement "Objects": action "GetObject" is not of the form service:action`,
+			},
+		},
+		"invalid resources": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:GetObject", "dynamodb:GetItem", "sqs:SendMessage"],
+      "Resource": [
+        "arn:aws:s3:us-west-2::my-bucket/*",
+        "arn:aws:dynamodb:us-west-2:123456789012:tables/my-table",
+        "arn:aws:sqs:::my-queue",
+        "my-bucket",
+        "arn:aws:s3"
+      ]
+    }
+  ]
+}`,
+			expected: []string{
+				`statement 0: resource "arn:aws:s3:us-west-2::my-bucket/*" does not match any s3 resource ARN format`,
+				`statement 0: resource "arn:aws:dynamodb:us-west-2:123456789012:tables/my-table" does not match any dynamodb resource ARN format`,
+				`statement 0: resource "arn:aws:sqs:::my-queue" does not match any sqs resource ARN format`,
+				`statement 0: resource "my-bucket" is not an ARN`,
+				`statement 0: resource "arn:aws:s3" is not a valid ARN`,
+			},
+		},
+		"unknown condition keys": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Deny",
+      "Action": "s3:*",
+      "Resource": "*",
+      "Condition": {
+        "Bool": {
+          "aws:SecureTransports": "false"
+        },
+        "StringNotEquals": {
+          "s3:x-amz-server-side-encryptions": "aws:kms"
+        }
+      }
+    }
+  ]
+}`,
+			expected: []string{
+				`statement 0: condition key "aws:SecureTransports" is not a known global condition key`,
+				`statement 0: condition key "s3:x-amz-server-side-encryptions" is not a known s3 condition key`,
+			},
+		},
+		"iam, ec2 and logs": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Valid",
+      "Effect": "Allow",
+      "Action": ["iam:PassRole", "ec2:RunInstances", "logs:CreateLogStream", "logs:PutLogEvents"],
+      "Resource": [
+        "arn:aws:iam::123456789012:role/service-role/my-role",
+        "arn:aws:ec2:us-west-2::image/ami-*",
+        "arn:aws:ec2:us-west-2:123456789012:subnet/subnet-12345678",
+        "arn:aws:logs:us-west-2:123456789012:log-group:/aws/lambda/my-function:*"
+      ],
+      "Condition": {
+        "StringEquals": {
+          "iam:PassedToService": "ec2.amazonaws.com"
+        }
+      }
+    },
+    {
+      "Sid": "Invalid",
+      "Effect": "Allow",
+      "Action": ["iam:PassRoles", "logs:PutLogEvent"],
+      "Resource": [
+        "arn:aws:iam::123456789012:roles/my-role",
+        "arn:aws:logs:us-west-2:123456789012:loggroup:my-log-group"
+      ]
+    }
+  ]
+}`,
+			expected: []string{
+				`statement "Invalid": action "iam:PassRoles" is not a known iam action`,
+				`statement "Invalid": action "logs:PutLogEvent" is not a known logs action`,
+				`statement "Invalid": resource "arn:aws:iam::123456789012:roles/my-role" does not match any iam resource ARN format`,
+				`statement "Invalid": resource "arn:aws:logs:us-west-2:123456789012:loggroup:my-log-group" does not match any logs resource ARN format`,
+			},
+		},
+		"overly permissive": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Admin",
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "AllButIAM",
+      "Effect": "Allow",
+      "NotAction": "iam:*",
+      "Resource": "*"
+    }
+  ]
+}`,
+			expected: []string{
+				`statement "Admin": allows all actions on all resources`,
+				`statement "AllButIAM": uses NotAction with Allow, which allows all actions that are not listed, including actions added to services in the future`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var doc tfiam.IAMPolicyDoc
+			if err := json.Unmarshal([]byte(testCase.policy), &doc); err != nil {
+				t.Fatalf("decoding policy: %s", err)
+			}
+
+			got := tfiam.LintPolicyDocument(&doc)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidPolicyJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy           string
+		expectedWarnings int
+		expectedErrors   int
+	}{
+		"valid": {
+			policy:           `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::my-bucket/*"}]}`,
+			expectedWarnings: 0,
+		},
+		"findings": {
+			policy:           `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObjects","Resource":"*"}]}`,
+			expectedWarnings: 1,
+		},
+		"single statement": {
+			policy:           `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObjects","Resource":"*"}}`,
+			expectedWarnings: 0,
+		},
+		"numeric condition values": {
+			policy:           `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"NumericLessThan":{"aws:MultiFactorAuthAges":[3600]}}}]}`,
+			expectedWarnings: 1,
+		},
+		"invalid JSON": {
+			policy:         `{"Version":"2012-10-17",`,
+			expectedErrors: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ws, errs := tfiam.ValidPolicyJSON(testCase.policy, "policy")
+
+			if got, expected := len(ws), testCase.expectedWarnings; got != expected {
+				t.Errorf("got %d warnings (%v), expected %d", got, ws, expected)
+			}
+			if got, expected := len(errs), testCase.expectedErrors; got != expected {
+				t.Errorf("got %d errors (%v), expected %d", got, errs, expected)
+			}
+		})
+	}
+}
@@ -156,7 +156,11 @@ func (ps *IAMPolicyStatementPrincipalSet) UnmarshalJSON(b []byte) error {
 			case []interface{}:
 				values := []string{}
 				for _, v := range value.([]interface{}) {
-					values = append(values, v.(string))
+					s, ok := v.(string)
+					if !ok {
+						return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet.Identifiers", v)
+					}
+					values = append(values, s)
 				}
 				slices.Sort(values)
 				out = append(out, IAMPolicyStatementPrincipal{Type: key, Identifiers: values})
@@ -224,7 +228,16 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 			case []interface{}:
 				values := []string{}
 				for _, v := range var_values {
-					values = append(values, v.(string))
+					switch v := v.(type) {
+					case string:
+						values = append(values, v)
+					case bool:
+						values = append(values, strconv.FormatBool(v))
+					case float64:
+						values = append(values, strconv.FormatFloat(v, 'f', -1, 64))
+					default:
+						return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementConditionSet.Values", v)
+					}
 				}
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: values})
 			}
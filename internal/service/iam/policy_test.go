@@ -349,6 +349,24 @@ func TestAccIAMPolicy_policyDuplicateKeys(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicy_validatePolicies(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_validatePolicies(rName),
+				ExpectError: regexache.MustCompile(`IAM Access Analyzer policy validation errors:\s+MISSING_ACTION`),
+			},
+		},
+	})
+}
+
 // TestAccIAMPolicy_malformedCondition verifies that malformed policy content
 // that is stored in state does not prevent subsequent plan and apply operations
 // from proceeding.
@@ -676,6 +694,27 @@ EOF
 `, rName)
 }
 
+func testAccPolicyConfig_validatePolicies(rName string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  validate_policies = true
+}
+
+resource "aws_iam_policy" "test" {
+  name = %q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
 func testAccPolicyConfig_MalformedCondition_setup(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_policy" "test" {
@@ -63,7 +63,7 @@ func resourceRolePolicy() *schema.Resource {
 			names.AttrPolicy: {
 				Type:                  schema.TypeString,
 				Required:              true,
-				ValidateFunc:          verify.ValidIAMPolicyJSON,
+				ValidateFunc:          validPolicyJSON,
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
 				StateFunc: func(v interface{}) string {
@@ -78,6 +78,8 @@ func resourceRolePolicy() *schema.Resource {
 				ValidateFunc: validRolePolicyRole,
 			},
 		},
+
+		CustomizeDiff: identityPolicyValidation.CustomizeDiff,
 	}
 }
 
@@ -116,6 +118,8 @@ func resourceRolePolicyPut(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	diags = append(diags, identityPolicyValidation.Warnings(ctx, d, meta)...)
+
 	return append(diags, resourceRolePolicyRead(ctx, d, meta)...)
 }
 
@@ -55,7 +55,7 @@ func resourceUserPolicy() *schema.Resource {
 			names.AttrPolicy: {
 				Type:                  schema.TypeString,
 				Required:              true,
-				ValidateFunc:          verify.ValidIAMPolicyJSON,
+				ValidateFunc:          validPolicyJSON,
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
 				StateFunc: func(v interface{}) string {
@@ -69,6 +69,8 @@ func resourceUserPolicy() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: identityPolicyValidation.CustomizeDiff,
 	}
 }
 
@@ -107,6 +109,8 @@ func resourceUserPolicyPut(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	diags = append(diags, identityPolicyValidation.Warnings(ctx, d, meta)...)
+
 	return append(diags, resourceUserPolicyRead(ctx, d, meta)...)
 }
 
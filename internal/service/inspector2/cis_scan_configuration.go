@@ -0,0 +1,565 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_inspector2_cis_scan_configuration", name="CIS Scan Configuration")
+// @Tags(identifierAttribute="arn")
+func newCISScanConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &cisScanConfigurationResource{}
+
+	return r, nil
+}
+
+type cisScanConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*cisScanConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_inspector2_cis_scan_configuration"
+}
+
+func (r *cisScanConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	scheduleTypePaths := []path.Expression{
+		path.MatchRelative().AtParent().AtName("daily"),
+		path.MatchRelative().AtParent().AtName("monthly"),
+		path.MatchRelative().AtParent().AtName("one_time"),
+		path.MatchRelative().AtParent().AtName("weekly"),
+	}
+	startTimeBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[cisTimeModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"time_of_day": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(regexache.MustCompile(`^([0-1]?[0-9]|2[0-3]):[0-5][0-9]$`), "must be a time in HH:MM format"),
+					},
+				},
+				"timezone": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 50),
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"scan_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"security_level": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CisSecurityLevel](),
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrSchedule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[cisScheduleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"daily": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cisDailyScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(scheduleTypePaths...),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									names.AttrStartTime: startTimeBlock,
+								},
+							},
+						},
+						"monthly": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cisMonthlyScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"day": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Day](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrStartTime: startTimeBlock,
+								},
+							},
+						},
+						"one_time": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cisOneTimeScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{},
+						},
+						"weekly": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cisWeeklyScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"days": schema.SetAttribute{
+										CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.Day]](ctx),
+										ElementType: fwtypes.StringEnumType[awstypes.Day](),
+										Required:    true,
+										Validators: []validator.Set{
+											setvalidator.SizeBetween(1, 7),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrStartTime: startTimeBlock,
+								},
+							},
+						},
+					},
+				},
+			},
+			"targets": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[cisTargetsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"account_ids": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(1, 10000),
+								setvalidator.ValueStringsAre(
+									stringvalidator.Any(
+										stringvalidator.OneOf("ALL_ACCOUNTS", "SELF"),
+										fwvalidators.AWSAccountID(),
+									),
+								),
+							},
+						},
+						"target_resource_tags": schema.MapAttribute{
+							ElementType: types.SetType{ElemType: types.StringType},
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *cisScanConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	name := data.ScanName.ValueString()
+	var input inspector2.CreateCisScanConfigurationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateCisScanConfiguration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Inspector2 CIS Scan Configuration (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ScanConfigurationARN = fwflex.StringToFramework(ctx, output.ScanConfigurationArn)
+	data.ID = data.ScanConfigurationARN
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *cisScanConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	output, err := findCISScanConfigurationByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Inspector2 CIS Scan Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *cisScanConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	if !new.ScanName.Equal(old.ScanName) ||
+		!new.Schedule.Equal(old.Schedule) ||
+		!new.SecurityLevel.Equal(old.SecurityLevel) ||
+		!new.Targets.Equal(old.Targets) {
+		var input inspector2.UpdateCisScanConfigurationInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateCisScanConfiguration(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Inspector2 CIS Scan Configuration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *cisScanConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	_, err := conn.DeleteCisScanConfiguration(ctx, &inspector2.DeleteCisScanConfigurationInput{
+		ScanConfigurationArn: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Inspector2 CIS Scan Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *cisScanConfigurationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findCISScanConfigurationByARN(ctx context.Context, conn *inspector2.Client, arn string) (*awstypes.CisScanConfiguration, error) {
+	input := &inspector2.ListCisScanConfigurationsInput{
+		FilterCriteria: &awstypes.ListCisScanConfigurationsFilterCriteria{
+			ScanConfigurationArnFilters: []awstypes.CisStringFilter{
+				{
+					Comparison: awstypes.CisStringComparisonEquals,
+					Value:      aws.String(arn),
+				},
+			},
+		},
+	}
+
+	return findCISScanConfiguration(ctx, conn, input)
+}
+
+func findCISScanConfiguration(ctx context.Context, conn *inspector2.Client, input *inspector2.ListCisScanConfigurationsInput) (*awstypes.CisScanConfiguration, error) {
+	output, err := findCISScanConfigurations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCISScanConfigurations(ctx context.Context, conn *inspector2.Client, input *inspector2.ListCisScanConfigurationsInput) ([]awstypes.CisScanConfiguration, error) {
+	var output []awstypes.CisScanConfiguration
+
+	pages := inspector2.NewListCisScanConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ScanConfigurations...)
+	}
+
+	return output, nil
+}
+
+type cisScanConfigurationResourceModel struct {
+	ID                   types.String                                      `tfsdk:"id"`
+	ScanConfigurationARN types.String                                      `tfsdk:"arn"`
+	ScanName             types.String                                      `tfsdk:"scan_name"`
+	Schedule             fwtypes.ListNestedObjectValueOf[cisScheduleModel] `tfsdk:"schedule"`
+	SecurityLevel        fwtypes.StringEnum[awstypes.CisSecurityLevel]     `tfsdk:"security_level"`
+	Tags                 tftags.Map                                        `tfsdk:"tags"`
+	TagsAll              tftags.Map                                        `tfsdk:"tags_all"`
+	Targets              fwtypes.ListNestedObjectValueOf[cisTargetsModel]  `tfsdk:"targets"`
+}
+
+type cisScheduleModel struct {
+	Daily   fwtypes.ListNestedObjectValueOf[cisDailyScheduleModel]   `tfsdk:"daily"`
+	Monthly fwtypes.ListNestedObjectValueOf[cisMonthlyScheduleModel] `tfsdk:"monthly"`
+	OneTime fwtypes.ListNestedObjectValueOf[cisOneTimeScheduleModel] `tfsdk:"one_time"`
+	Weekly  fwtypes.ListNestedObjectValueOf[cisWeeklyScheduleModel]  `tfsdk:"weekly"`
+}
+
+var (
+	_ fwflex.Expander  = cisScheduleModel{}
+	_ fwflex.Flattener = &cisScheduleModel{}
+)
+
+func (m cisScheduleModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Daily.IsNull():
+		data, d := m.Daily.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ScheduleMemberDaily
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Monthly.IsNull():
+		data, d := m.Monthly.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ScheduleMemberMonthly
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.OneTime.IsNull():
+		return &awstypes.ScheduleMemberOneTime{}, diags
+
+	case !m.Weekly.IsNull():
+		data, d := m.Weekly.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ScheduleMemberWeekly
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *cisScheduleModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.ScheduleMemberDaily:
+		var data cisDailyScheduleModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+		m.Daily = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.ScheduleMemberMonthly:
+		var data cisMonthlyScheduleModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+		m.Monthly = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.ScheduleMemberOneTime:
+		m.OneTime = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &cisOneTimeScheduleModel{})
+
+	case awstypes.ScheduleMemberWeekly:
+		var data cisWeeklyScheduleModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+		m.Weekly = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	default:
+		diags.AddError("Unsupported Type", fmt.Sprintf("schedule flatten: %T", v))
+	}
+
+	return diags
+}
+
+type cisDailyScheduleModel struct {
+	StartTime fwtypes.ListNestedObjectValueOf[cisTimeModel] `tfsdk:"start_time"`
+}
+
+type cisMonthlyScheduleModel struct {
+	Day       fwtypes.StringEnum[awstypes.Day]              `tfsdk:"day"`
+	StartTime fwtypes.ListNestedObjectValueOf[cisTimeModel] `tfsdk:"start_time"`
+}
+
+type cisOneTimeScheduleModel struct{}
+
+type cisWeeklyScheduleModel struct {
+	Days      fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.Day]] `tfsdk:"days"`
+	StartTime fwtypes.ListNestedObjectValueOf[cisTimeModel]        `tfsdk:"start_time"`
+}
+
+type cisTimeModel struct {
+	TimeOfDay types.String `tfsdk:"time_of_day"`
+	Timezone  types.String `tfsdk:"timezone"`
+}
+
+// cisTargetsModel is expanded by hand as AutoFlex does not support map[string][]string.
+type cisTargetsModel struct {
+	AccountIDs         types.Set `tfsdk:"account_ids"`
+	TargetResourceTags types.Map `tfsdk:"target_resource_tags"`
+}
+
+var (
+	_ fwflex.TypedExpander = cisTargetsModel{}
+	_ fwflex.Flattener     = &cisTargetsModel{}
+)
+
+func (m cisTargetsModel) ExpandTo(ctx context.Context, targetType reflect.Type) (result any, diags diag.Diagnostics) {
+	accountIDs := fwflex.ExpandFrameworkStringValueSet(ctx, m.AccountIDs)
+
+	var targetResourceTags map[string][]string
+	if !m.TargetResourceTags.IsNull() && !m.TargetResourceTags.IsUnknown() {
+		diags.Append(m.TargetResourceTags.ElementsAs(ctx, &targetResourceTags, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	switch targetType {
+	case reflect.TypeFor[awstypes.CreateCisTargets]():
+		return &awstypes.CreateCisTargets{
+			AccountIds:         accountIDs,
+			TargetResourceTags: targetResourceTags,
+		}, diags
+
+	case reflect.TypeFor[awstypes.UpdateCisTargets]():
+		return &awstypes.UpdateCisTargets{
+			AccountIds:         accountIDs,
+			TargetResourceTags: targetResourceTags,
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *cisTargetsModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	t, ok := v.(awstypes.CisTargets)
+	if !ok {
+		diags.AddError("Unsupported Type", fmt.Sprintf("targets flatten: %T", v))
+		return diags
+	}
+
+	m.AccountIDs = fwflex.FlattenFrameworkStringValueSet(ctx, t.AccountIds)
+
+	targetResourceTags, d := types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, t.TargetResourceTags)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	m.TargetResourceTags = targetResourceTags
+
+	return diags
+}
@@ -0,0 +1,403 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccCISScanConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CisScanConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_basic(rName, string(awstypes.CisSecurityLevelLevel1)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "scan_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.one_time.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_level", string(awstypes.CisSecurityLevelLevel1)),
+					resource.TestCheckResourceAttr(resourceName, "targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "targets.0.account_ids.*", "data.aws_caller_identity.current", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.target_resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.target_resource_tags.Environment.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_basic(rName, string(awstypes.CisSecurityLevelLevel2)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "security_level", string(awstypes.CisSecurityLevelLevel2)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCISScanConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CisScanConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_basic(rName, string(awstypes.CisSecurityLevelLevel1)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfinspector2.ResourceCISScanConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCISScanConfiguration_schedule(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CisScanConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_scheduleDaily(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.0.start_time.0.time_of_day", "12:30"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.0.start_time.0.timezone", "Etc/UTC"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_scheduleWeekly(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.0.days.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "schedule.0.weekly.0.days.*", string(awstypes.DayMon)),
+					resource.TestCheckTypeSetElemAttr(resourceName, "schedule.0.weekly.0.days.*", string(awstypes.DayThu)),
+				),
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_scheduleMonthly(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.monthly.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.monthly.0.day", string(awstypes.DaySun)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCISScanConfiguration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CisScanConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCISScanConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_inspector2_cis_scan_configuration" {
+				continue
+			}
+
+			_, err := tfinspector2.FindCISScanConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Inspector2 CIS Scan Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCISScanConfigurationExists(ctx context.Context, n string, v *awstypes.CisScanConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		output, err := tfinspector2.FindCISScanConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCISScanConfigurationConfig_basic(rName, securityLevel string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  scan_name      = %[1]q
+  security_level = %[2]q
+
+  schedule {
+    one_time {}
+  }
+
+  targets {
+    account_ids = [data.aws_caller_identity.current.account_id]
+
+    target_resource_tags = {
+      Environment = ["test"]
+    }
+  }
+}
+`, rName, securityLevel)
+}
+
+func testAccCISScanConfigurationConfig_scheduleDaily(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  scan_name      = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    daily {
+      start_time {
+        time_of_day = "12:30"
+        timezone    = "Etc/UTC"
+      }
+    }
+  }
+
+  targets {
+    account_ids = ["SELF"]
+
+    target_resource_tags = {
+      Environment = ["test"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCISScanConfigurationConfig_scheduleWeekly(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  scan_name      = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    weekly {
+      days = ["MON", "THU"]
+
+      start_time {
+        time_of_day = "12:30"
+        timezone    = "Etc/UTC"
+      }
+    }
+  }
+
+  targets {
+    account_ids = ["SELF"]
+
+    target_resource_tags = {
+      Environment = ["test"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCISScanConfigurationConfig_scheduleMonthly(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  scan_name      = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    monthly {
+      day = "SUN"
+
+      start_time {
+        time_of_day = "12:30"
+        timezone    = "Etc/UTC"
+      }
+    }
+  }
+
+  targets {
+    account_ids = ["SELF"]
+
+    target_resource_tags = {
+      Environment = ["test"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCISScanConfigurationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  scan_name      = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    one_time {}
+  }
+
+  targets {
+    account_ids = ["SELF"]
+
+    target_resource_tags = {
+      Environment = ["test"]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccCISScanConfigurationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  scan_name      = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    one_time {}
+  }
+
+  targets {
+    account_ids = ["SELF"]
+
+    target_resource_tags = {
+      Environment = ["test"]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -5,11 +5,15 @@ package inspector2
 
 // Exports for use in tests only.
 var (
+	ResourceCISScanConfiguration      = newCISScanConfigurationResource
 	ResourceDelegatedAdminAccount     = resourceDelegatedAdminAccount
+	ResourceFilter                    = newFilterResource
 	ResourceMemberAssociation         = resourceMemberAssociation
 	ResourceOrganizationConfiguration = resourceOrganizationConfiguration
 
+	FindCISScanConfigurationByARN = findCISScanConfigurationByARN
 	FindDelegatedAdminAccountByID = findDelegatedAdminAccountByID
+	FindFilterByARN               = findFilterByARN
 	FindMemberByAccountID         = findMemberByAccountID
 	FindOrganizationConfiguration = findOrganizationConfiguration
 
@@ -0,0 +1,560 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_inspector2_filter", name="Filter")
+// @Tags(identifierAttribute="arn")
+func newFilterResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &filterResource{}
+
+	return r, nil
+}
+
+type filterResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*filterResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_inspector2_filter"
+}
+
+func (r *filterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAction: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FilterAction](),
+				Required:   true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"reason": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"filter_criteria": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[filterCriteriaModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: filterCriteriaResourceBlocks(ctx),
+				},
+			},
+		},
+	}
+}
+
+func (r *filterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data filterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	name := data.Name.ValueString()
+	var input inspector2.CreateFilterInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFilter(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Inspector2 Filter (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.ID = data.ARN
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *filterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data filterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	filter, err := findFilterByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Inspector2 Filter (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, filter, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var criteria filterCriteriaModel
+	response.Diagnostics.Append(fwflex.Flatten(ctx, filter.Criteria, &criteria)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.FilterCriteria = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &criteria)
+
+	setTagsOut(ctx, filter.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *filterResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new filterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	if !new.Action.Equal(old.Action) ||
+		!new.Description.Equal(old.Description) ||
+		!new.FilterCriteria.Equal(old.FilterCriteria) ||
+		!new.Name.Equal(old.Name) ||
+		!new.Reason.Equal(old.Reason) {
+		var input inspector2.UpdateFilterInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.FilterArn = fwflex.StringFromFramework(ctx, new.ID)
+
+		_, err := conn.UpdateFilter(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Inspector2 Filter (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *filterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data filterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	_, err := conn.DeleteFilter(ctx, &inspector2.DeleteFilterInput{
+		Arn: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Inspector2 Filter (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *filterResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findFilterByARN(ctx context.Context, conn *inspector2.Client, arn string) (*awstypes.Filter, error) {
+	input := &inspector2.ListFiltersInput{
+		Arns: []string{arn},
+	}
+
+	return findFilter(ctx, conn, input)
+}
+
+func findFilter(ctx context.Context, conn *inspector2.Client, input *inspector2.ListFiltersInput) (*awstypes.Filter, error) {
+	output, err := findFilters(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findFilters(ctx context.Context, conn *inspector2.Client, input *inspector2.ListFiltersInput) ([]awstypes.Filter, error) {
+	var output []awstypes.Filter
+
+	pages := inspector2.NewListFiltersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Filters...)
+	}
+
+	return output, nil
+}
+
+type filterResourceModel struct {
+	Action         fwtypes.StringEnum[awstypes.FilterAction]            `tfsdk:"action"`
+	ARN            types.String                                         `tfsdk:"arn"`
+	Description    types.String                                         `tfsdk:"description"`
+	FilterCriteria fwtypes.ListNestedObjectValueOf[filterCriteriaModel] `tfsdk:"filter_criteria"`
+	ID             types.String                                         `tfsdk:"id"`
+	Name           types.String                                         `tfsdk:"name"`
+	Reason         types.String                                         `tfsdk:"reason"`
+	Tags           tftags.Map                                           `tfsdk:"tags"`
+	TagsAll        tftags.Map                                           `tfsdk:"tags_all"`
+}
+
+// filterCriteriaModel mirrors awstypes.FilterCriteria.
+// It is shared by the aws_inspector2_filter resource and the aws_inspector2_findings data source.
+type filterCriteriaModel struct {
+	AwsAccountId                   fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"aws_account_id"`
+	CodeVulnerabilityDetectorName  fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"code_vulnerability_detector_name"`
+	CodeVulnerabilityDetectorTags  fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"code_vulnerability_detector_tags"`
+	CodeVulnerabilityFilePath      fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"code_vulnerability_file_path"`
+	ComponentId                    fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"component_id"`
+	ComponentType                  fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"component_type"`
+	Ec2InstanceImageId             fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ec2_instance_image_id"`
+	Ec2InstanceSubnetId            fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ec2_instance_subnet_id"`
+	Ec2InstanceVpcId               fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ec2_instance_vpc_id"`
+	EcrImageArchitecture           fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_architecture"`
+	EcrImageHash                   fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_hash"`
+	EcrImagePushedAt               fwtypes.ListNestedObjectValueOf[dateFilterModel]      `tfsdk:"ecr_image_pushed_at"`
+	EcrImageRegistry               fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_registry"`
+	EcrImageRepositoryName         fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_repository_name"`
+	EcrImageTags                   fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_tags"`
+	EpssScore                      fwtypes.ListNestedObjectValueOf[numberFilterModel]    `tfsdk:"epss_score"`
+	ExploitAvailable               fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"exploit_available"`
+	FindingArn                     fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"finding_arn"`
+	FindingStatus                  fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"finding_status"`
+	FindingType                    fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"finding_type"`
+	FirstObservedAt                fwtypes.ListNestedObjectValueOf[dateFilterModel]      `tfsdk:"first_observed_at"`
+	FixAvailable                   fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"fix_available"`
+	InspectorScore                 fwtypes.ListNestedObjectValueOf[numberFilterModel]    `tfsdk:"inspector_score"`
+	LambdaFunctionExecutionRoleArn fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_execution_role_arn"`
+	LambdaFunctionLastModifiedAt   fwtypes.ListNestedObjectValueOf[dateFilterModel]      `tfsdk:"lambda_function_last_modified_at"`
+	LambdaFunctionLayers           fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_layers"`
+	LambdaFunctionName             fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_name"`
+	LambdaFunctionRuntime          fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_runtime"`
+	LastObservedAt                 fwtypes.ListNestedObjectValueOf[dateFilterModel]      `tfsdk:"last_observed_at"`
+	NetworkProtocol                fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"network_protocol"`
+	PortRange                      fwtypes.ListNestedObjectValueOf[portRangeFilterModel] `tfsdk:"port_range"`
+	RelatedVulnerabilities         fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"related_vulnerabilities"`
+	ResourceId                     fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"resource_id"`
+	ResourceTags                   fwtypes.ListNestedObjectValueOf[mapFilterModel]       `tfsdk:"resource_tags"`
+	ResourceType                   fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"resource_type"`
+	Severity                       fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"severity"`
+	Title                          fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"title"`
+	UpdatedAt                      fwtypes.ListNestedObjectValueOf[dateFilterModel]      `tfsdk:"updated_at"`
+	VendorSeverity                 fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"vendor_severity"`
+	VulnerabilityId                fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"vulnerability_id"`
+	VulnerabilitySource            fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"vulnerability_source"`
+	VulnerablePackages             fwtypes.ListNestedObjectValueOf[packageFilterModel]   `tfsdk:"vulnerable_packages"`
+}
+
+type stringFilterModel struct {
+	Comparison fwtypes.StringEnum[awstypes.StringComparison] `tfsdk:"comparison"`
+	Value      types.String                                  `tfsdk:"value"`
+}
+
+type dateFilterModel struct {
+	EndInclusive   timetypes.RFC3339 `tfsdk:"end_inclusive"`
+	StartInclusive timetypes.RFC3339 `tfsdk:"start_inclusive"`
+}
+
+type numberFilterModel struct {
+	LowerInclusive types.Float64 `tfsdk:"lower_inclusive"`
+	UpperInclusive types.Float64 `tfsdk:"upper_inclusive"`
+}
+
+type portRangeFilterModel struct {
+	BeginInclusive types.Int64 `tfsdk:"begin_inclusive"`
+	EndInclusive   types.Int64 `tfsdk:"end_inclusive"`
+}
+
+type mapFilterModel struct {
+	Comparison fwtypes.StringEnum[awstypes.MapComparison] `tfsdk:"comparison"`
+	Key        types.String                               `tfsdk:"key"`
+	Value      types.String                               `tfsdk:"value"`
+}
+
+type packageFilterModel struct {
+	Architecture         fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"architecture"`
+	Epoch                fwtypes.ListNestedObjectValueOf[numberFilterModel] `tfsdk:"epoch"`
+	FilePath             fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"file_path"`
+	Name                 fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"name"`
+	Release              fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"release"`
+	SourceLambdaLayerArn fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"source_lambda_layer_arn"`
+	SourceLayerHash      fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"source_layer_hash"`
+	Version              fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"version"`
+}
+
+type filterKind int
+
+const (
+	filterKindString filterKind = iota
+	filterKindDate
+	filterKindNumber
+	filterKindPortRange
+	filterKindMap
+	filterKindPackage
+)
+
+// filterCriteria maps each FilterCriteria block to the kind of filter it holds.
+// The schemas of both the resource and the data source are generated from it.
+var filterCriteria = map[string]filterKind{
+	"aws_account_id":                     filterKindString,
+	"code_vulnerability_detector_name":   filterKindString,
+	"code_vulnerability_detector_tags":   filterKindString,
+	"code_vulnerability_file_path":       filterKindString,
+	"component_id":                       filterKindString,
+	"component_type":                     filterKindString,
+	"ec2_instance_image_id":              filterKindString,
+	"ec2_instance_subnet_id":             filterKindString,
+	"ec2_instance_vpc_id":                filterKindString,
+	"ecr_image_architecture":             filterKindString,
+	"ecr_image_hash":                     filterKindString,
+	"ecr_image_pushed_at":                filterKindDate,
+	"ecr_image_registry":                 filterKindString,
+	"ecr_image_repository_name":          filterKindString,
+	"ecr_image_tags":                     filterKindString,
+	"epss_score":                         filterKindNumber,
+	"exploit_available":                  filterKindString,
+	"finding_arn":                        filterKindString,
+	"finding_status":                     filterKindString,
+	"finding_type":                       filterKindString,
+	"first_observed_at":                  filterKindDate,
+	"fix_available":                      filterKindString,
+	"inspector_score":                    filterKindNumber,
+	"lambda_function_execution_role_arn": filterKindString,
+	"lambda_function_last_modified_at":   filterKindDate,
+	"lambda_function_layers":             filterKindString,
+	"lambda_function_name":               filterKindString,
+	"lambda_function_runtime":            filterKindString,
+	"last_observed_at":                   filterKindDate,
+	"network_protocol":                   filterKindString,
+	"port_range":                         filterKindPortRange,
+	"related_vulnerabilities":            filterKindString,
+	"resource_id":                        filterKindString,
+	"resource_tags":                      filterKindMap,
+	"resource_type":                      filterKindString,
+	"severity":                           filterKindString,
+	"title":                              filterKindString,
+	"updated_at":                         filterKindDate,
+	"vendor_severity":                    filterKindString,
+	"vulnerability_id":                   filterKindString,
+	"vulnerability_source":               filterKindString,
+	"vulnerable_packages":                filterKindPackage,
+}
+
+// packageFilterCriteria maps each PackageFilter block to the kind of filter it holds.
+var packageFilterCriteria = map[string]filterKind{
+	"architecture":            filterKindString,
+	"epoch":                   filterKindNumber,
+	"file_path":               filterKindString,
+	names.AttrName:            filterKindString,
+	"release":                 filterKindString,
+	"source_lambda_layer_arn": filterKindString,
+	"source_layer_hash":       filterKindString,
+	names.AttrVersion:         filterKindString,
+}
+
+func filterCriteriaResourceBlocks(ctx context.Context) map[string]schema.Block {
+	blocks := make(map[string]schema.Block, len(filterCriteria))
+
+	for name, kind := range filterCriteria {
+		blocks[name] = filterResourceBlock(ctx, kind, listvalidator.SizeAtMost(10))
+	}
+
+	return blocks
+}
+
+func filterResourceBlock(ctx context.Context, kind filterKind, validators ...validator.List) schema.ListNestedBlock {
+	var nestedObject schema.NestedBlockObject
+
+	switch kind {
+	case filterKindString:
+		nestedObject = schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"comparison": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.StringComparison](),
+					Required:   true,
+				},
+				names.AttrValue: schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 1024),
+					},
+				},
+			},
+		}
+		return schema.ListNestedBlock{
+			CustomType:   fwtypes.NewListNestedObjectTypeOf[stringFilterModel](ctx),
+			Validators:   validators,
+			NestedObject: nestedObject,
+		}
+
+	case filterKindDate:
+		nestedObject = schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"end_inclusive": schema.StringAttribute{
+					CustomType: timetypes.RFC3339Type{},
+					Optional:   true,
+				},
+				"start_inclusive": schema.StringAttribute{
+					CustomType: timetypes.RFC3339Type{},
+					Optional:   true,
+				},
+			},
+		}
+		return schema.ListNestedBlock{
+			CustomType:   fwtypes.NewListNestedObjectTypeOf[dateFilterModel](ctx),
+			Validators:   validators,
+			NestedObject: nestedObject,
+		}
+
+	case filterKindNumber:
+		nestedObject = schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"lower_inclusive": schema.Float64Attribute{
+					Optional: true,
+				},
+				"upper_inclusive": schema.Float64Attribute{
+					Optional: true,
+				},
+			},
+		}
+		return schema.ListNestedBlock{
+			CustomType:   fwtypes.NewListNestedObjectTypeOf[numberFilterModel](ctx),
+			Validators:   validators,
+			NestedObject: nestedObject,
+		}
+
+	case filterKindPortRange:
+		nestedObject = schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"begin_inclusive": schema.Int64Attribute{
+					Optional: true,
+				},
+				"end_inclusive": schema.Int64Attribute{
+					Optional: true,
+				},
+			},
+		}
+		return schema.ListNestedBlock{
+			CustomType:   fwtypes.NewListNestedObjectTypeOf[portRangeFilterModel](ctx),
+			Validators:   validators,
+			NestedObject: nestedObject,
+		}
+
+	case filterKindMap:
+		nestedObject = schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"comparison": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.MapComparison](),
+					Required:   true,
+				},
+				names.AttrKey: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrValue: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		}
+		return schema.ListNestedBlock{
+			CustomType:   fwtypes.NewListNestedObjectTypeOf[mapFilterModel](ctx),
+			Validators:   validators,
+			NestedObject: nestedObject,
+		}
+
+	case filterKindPackage:
+		blocks := make(map[string]schema.Block, len(packageFilterCriteria))
+		for name, kind := range packageFilterCriteria {
+			blocks[name] = filterResourceBlock(ctx, kind, listvalidator.SizeAtMost(1))
+		}
+		nestedObject = schema.NestedBlockObject{
+			Blocks: blocks,
+		}
+		return schema.ListNestedBlock{
+			CustomType:   fwtypes.NewListNestedObjectTypeOf[packageFilterModel](ctx),
+			Validators:   validators,
+			NestedObject: nestedObject,
+		}
+	}
+
+	panic(fmt.Sprintf("unsupported filter kind: %d", kind))
+}
@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccFilter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Filter
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_basic(rName, string(awstypes.FilterActionNone), "description 1", "reason 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAction, string(awstypes.FilterActionNone)),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "inspector2", regexache.MustCompile(`owner/\d{12}/filter/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.aws_account_id.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.aws_account_id.0.comparison", string(awstypes.StringComparisonEquals)),
+					resource.TestCheckResourceAttrPair(resourceName, "filter_criteria.0.aws_account_id.0.value", "data.aws_caller_identity.current", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "reason", "reason 1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFilterConfig_basic(rName, string(awstypes.FilterActionSuppress), "description 2", "reason 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAction, string(awstypes.FilterActionSuppress)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "reason", "reason 2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFilter_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Filter
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_basic(rName, string(awstypes.FilterActionNone), "description", "reason"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfinspector2.ResourceFilter, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccFilter_filterCriteria(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Filter
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_filterCriteria(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.ecr_image_pushed_at.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.ecr_image_pushed_at.0.start_inclusive", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.inspector_score.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.inspector_score.0.lower_inclusive", "0"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.inspector_score.0.upper_inclusive", "4.5"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.port_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.port_range.0.begin_inclusive", "80"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.port_range.0.end_inclusive", "443"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.resource_tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.resource_tags.0.comparison", string(awstypes.MapComparisonEquals)),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.resource_tags.0.key", "Environment"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.resource_tags.0.value", "test"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.severity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.vulnerable_packages.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.vulnerable_packages.0.name.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.vulnerable_packages.0.name.0.value", "openssl"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFilter_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Filter
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFilterConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccFilterConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFilterDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_inspector2_filter" {
+				continue
+			}
+
+			_, err := tfinspector2.FindFilterByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Inspector2 Filter %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFilterExists(ctx context.Context, n string, v *awstypes.Filter) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		output, err := tfinspector2.FindFilterByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFilterConfig_basic(rName, action, description, reason string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_filter" "test" {
+  name        = %[1]q
+  action      = %[2]q
+  description = %[3]q
+  reason      = %[4]q
+
+  filter_criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = data.aws_caller_identity.current.account_id
+    }
+  }
+}
+`, rName, action, description, reason)
+}
+
+func testAccFilterConfig_filterCriteria(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "NONE"
+
+  filter_criteria {
+    ecr_image_pushed_at {
+      start_inclusive = "2024-01-01T00:00:00Z"
+    }
+
+    inspector_score {
+      lower_inclusive = 0
+      upper_inclusive = 4.5
+    }
+
+    port_range {
+      begin_inclusive = 80
+      end_inclusive   = 443
+    }
+
+    resource_tags {
+      comparison = "EQUALS"
+      key        = "Environment"
+      value      = "test"
+    }
+
+    severity {
+      comparison = "EQUALS"
+      value      = "LOW"
+    }
+
+    severity {
+      comparison = "EQUALS"
+      value      = "INFORMATIONAL"
+    }
+
+    vulnerable_packages {
+      name {
+        comparison = "EQUALS"
+        value      = "openssl"
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccFilterConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "NONE"
+
+  filter_criteria {
+    severity {
+      comparison = "EQUALS"
+      value      = "LOW"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFilterConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "NONE"
+
+  filter_criteria {
+    severity {
+      comparison = "EQUALS"
+      value      = "LOW"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// findingsDefaultMaxResults is the number of findings returned if max_results is not set.
+	findingsDefaultMaxResults = 100
+	// See https://docs.aws.amazon.com/inspector/v2/APIReference/API_ListFindings.html#inspector2-ListFindings-request-maxResults.
+	listFindingsMaxResults = 100
+)
+
+// @FrameworkDataSource("aws_inspector2_findings", name="Findings")
+func newFindingsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &findingsDataSource{}, nil
+}
+
+type findingsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*findingsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_inspector2_findings"
+}
+
+func (d *findingsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"findings": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[findingModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[findingModel](ctx),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"max_results": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter_criteria": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[filterCriteriaModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: filterCriteriaDataSourceBlocks(ctx),
+				},
+			},
+			"sort_criteria": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sortCriteriaModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrField: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.SortField](),
+							Required:   true,
+						},
+						"sort_order": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.SortOrder](),
+							Required:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *findingsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data findingsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().Inspector2Client(ctx)
+
+	var input inspector2.ListFindingsInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	maxResults := findingsDefaultMaxResults
+	if !data.MaxResults.IsNull() {
+		maxResults = int(data.MaxResults.ValueInt64())
+	}
+	input.MaxResults = aws.Int32(int32(min(maxResults, listFindingsMaxResults)))
+
+	findings, err := findFindings(ctx, conn, &input, maxResults)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Inspector2 Findings", err.Error())
+
+		return
+	}
+
+	var output inspector2.ListFindingsOutput
+	output.Findings = findings
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().Region(ctx))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// findFindings returns at most maxResults findings.
+func findFindings(ctx context.Context, conn *inspector2.Client, input *inspector2.ListFindingsInput, maxResults int) ([]awstypes.Finding, error) {
+	var output []awstypes.Finding
+
+	pages := inspector2.NewListFindingsPaginator(conn, input)
+	for pages.HasMorePages() && len(output) < maxResults {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	if len(output) > maxResults {
+		output = output[:maxResults]
+	}
+
+	return output, nil
+}
+
+func filterCriteriaDataSourceBlocks(ctx context.Context) map[string]schema.Block {
+	blocks := make(map[string]schema.Block, len(filterCriteria))
+
+	for name, kind := range filterCriteria {
+		blocks[name] = filterDataSourceBlock(ctx, kind, listvalidator.SizeAtMost(10))
+	}
+
+	return blocks
+}
+
+func filterDataSourceBlock(ctx context.Context, kind filterKind, validators ...validator.List) schema.ListNestedBlock {
+	switch kind {
+	case filterKindString:
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[stringFilterModel](ctx),
+			Validators: validators,
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"comparison": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.StringComparison](),
+						Required:   true,
+					},
+					names.AttrValue: schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		}
+
+	case filterKindDate:
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[dateFilterModel](ctx),
+			Validators: validators,
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"end_inclusive": schema.StringAttribute{
+						CustomType: timetypes.RFC3339Type{},
+						Optional:   true,
+					},
+					"start_inclusive": schema.StringAttribute{
+						CustomType: timetypes.RFC3339Type{},
+						Optional:   true,
+					},
+				},
+			},
+		}
+
+	case filterKindNumber:
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[numberFilterModel](ctx),
+			Validators: validators,
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"lower_inclusive": schema.Float64Attribute{
+						Optional: true,
+					},
+					"upper_inclusive": schema.Float64Attribute{
+						Optional: true,
+					},
+				},
+			},
+		}
+
+	case filterKindPortRange:
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[portRangeFilterModel](ctx),
+			Validators: validators,
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"begin_inclusive": schema.Int64Attribute{
+						Optional: true,
+					},
+					"end_inclusive": schema.Int64Attribute{
+						Optional: true,
+					},
+				},
+			},
+		}
+
+	case filterKindMap:
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[mapFilterModel](ctx),
+			Validators: validators,
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"comparison": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.MapComparison](),
+						Required:   true,
+					},
+					names.AttrKey: schema.StringAttribute{
+						Required: true,
+					},
+					names.AttrValue: schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		}
+
+	case filterKindPackage:
+		blocks := make(map[string]schema.Block, len(packageFilterCriteria))
+		for name, kind := range packageFilterCriteria {
+			blocks[name] = filterDataSourceBlock(ctx, kind, listvalidator.SizeAtMost(1))
+		}
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[packageFilterModel](ctx),
+			Validators: validators,
+			NestedObject: schema.NestedBlockObject{
+				Blocks: blocks,
+			},
+		}
+	}
+
+	panic(fmt.Sprintf("unsupported filter kind: %d", kind))
+}
+
+type findingsDataSourceModel struct {
+	FilterCriteria fwtypes.ListNestedObjectValueOf[filterCriteriaModel] `tfsdk:"filter_criteria"`
+	Findings       fwtypes.ListNestedObjectValueOf[findingModel]        `tfsdk:"findings"`
+	ID             types.String                                         `tfsdk:"id"`
+	MaxResults     types.Int64                                          `tfsdk:"max_results"`
+	SortCriteria   fwtypes.ListNestedObjectValueOf[sortCriteriaModel]   `tfsdk:"sort_criteria"`
+}
+
+type sortCriteriaModel struct {
+	Field     fwtypes.StringEnum[awstypes.SortField] `tfsdk:"field"`
+	SortOrder fwtypes.StringEnum[awstypes.SortOrder] `tfsdk:"sort_order"`
+}
+
+type findingModel struct {
+	AwsAccountId     types.String                                          `tfsdk:"aws_account_id"`
+	Description      types.String                                          `tfsdk:"description"`
+	ExploitAvailable fwtypes.StringEnum[awstypes.ExploitAvailable]         `tfsdk:"exploit_available"`
+	FindingArn       types.String                                          `tfsdk:"finding_arn"`
+	FirstObservedAt  timetypes.RFC3339                                     `tfsdk:"first_observed_at"`
+	FixAvailable     fwtypes.StringEnum[awstypes.FixAvailable]             `tfsdk:"fix_available"`
+	InspectorScore   types.Float64                                         `tfsdk:"inspector_score"`
+	LastObservedAt   timetypes.RFC3339                                     `tfsdk:"last_observed_at"`
+	Resources        fwtypes.ListNestedObjectValueOf[findingResourceModel] `tfsdk:"resources"`
+	Severity         fwtypes.StringEnum[awstypes.Severity]                 `tfsdk:"severity"`
+	Status           fwtypes.StringEnum[awstypes.FindingStatus]            `tfsdk:"status"`
+	Title            types.String                                          `tfsdk:"title"`
+	Type             fwtypes.StringEnum[awstypes.FindingType]              `tfsdk:"type"`
+	UpdatedAt        timetypes.RFC3339                                     `tfsdk:"updated_at"`
+}
+
+type findingResourceModel struct {
+	Id        types.String                              `tfsdk:"id"`
+	Partition types.String                              `tfsdk:"partition"`
+	Region    types.String                              `tfsdk:"region"`
+	Type      fwtypes.StringEnum[awstypes.ResourceType] `tfsdk:"type"`
+}
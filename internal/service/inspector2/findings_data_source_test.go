@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccFindingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_inspector2_findings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingsDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "max_results", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "sort_criteria.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFindingsDataSourceConfig_basic() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_inspector2_findings" "test" {
+  max_results = 5
+
+  filter_criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = data.aws_caller_identity.current.account_id
+    }
+
+    finding_status {
+      comparison = "EQUALS"
+      value      = "ACTIVE"
+    }
+
+    severity {
+      comparison = "EQUALS"
+      value      = "CRITICAL"
+    }
+  }
+
+  sort_criteria {
+    field      = "INSPECTOR_SCORE"
+    sort_order = "DESC"
+  }
+}
+`
+}
@@ -27,10 +27,25 @@ func TestAccInspector2_serial(t *testing.T) {
 			"memberAccount_updateMemberAccountsAndScanTypes": testAccEnabler_memberAccount_updateMemberAccountsAndScanTypes,
 			"memberAccount_disappearsMemberAssociation":      testAccEnabler_memberAccount_disappearsMemberAssociation,
 		},
+		"CISScanConfiguration": {
+			acctest.CtBasic:      testAccCISScanConfiguration_basic,
+			acctest.CtDisappears: testAccCISScanConfiguration_disappears,
+			"schedule":           testAccCISScanConfiguration_schedule,
+			"tags":               testAccCISScanConfiguration_tags,
+		},
 		"DelegatedAdminAccount": {
 			acctest.CtBasic:      testAccDelegatedAdminAccount_basic,
 			acctest.CtDisappears: testAccDelegatedAdminAccount_disappears,
 		},
+		"Filter": {
+			acctest.CtBasic:      testAccFilter_basic,
+			acctest.CtDisappears: testAccFilter_disappears,
+			"filterCriteria":     testAccFilter_filterCriteria,
+			"tags":               testAccFilter_tags,
+		},
+		"FindingsDataSource": {
+			acctest.CtBasic: testAccFindingsDataSource_basic,
+		},
 		"MemberAssociation": {
 			acctest.CtBasic:      testAccMemberAssociation_basic,
 			acctest.CtDisappears: testAccMemberAssociation_disappears,
@@ -80,7 +80,7 @@ func resourceCACertificate() *schema.Resource {
 						names.AttrRoleARN: {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidIAMRoleARN,
 						},
 						"template_body": {
 							Type:         schema.TypeString,
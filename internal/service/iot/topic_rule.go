@@ -94,7 +94,7 @@ func resourceTopicRule() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							"state_reason": {
 								Type:     schema.TypeString,
@@ -125,7 +125,7 @@ func resourceTopicRule() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 						},
 					},
@@ -159,7 +159,7 @@ func resourceTopicRule() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 						},
 					},
@@ -213,7 +213,7 @@ func resourceTopicRule() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							names.AttrTableName: {
 								Type:     schema.TypeString,
@@ -243,7 +243,7 @@ func resourceTopicRule() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 						},
 					},
@@ -269,7 +269,7 @@ func resourceTopicRule() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							names.AttrType: {
 								Type:     schema.TypeString,
@@ -301,7 +301,7 @@ func resourceTopicRule() *schema.Resource {
 										names.AttrRoleARN: {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 										"state_reason": {
 											Type:     schema.TypeString,
@@ -334,7 +334,7 @@ func resourceTopicRule() *schema.Resource {
 										names.AttrRoleARN: {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 									},
 								},
@@ -370,7 +370,7 @@ func resourceTopicRule() *schema.Resource {
 										names.AttrRoleARN: {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 									},
 								},
@@ -422,7 +422,7 @@ func resourceTopicRule() *schema.Resource {
 										names.AttrRoleARN: {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 										names.AttrTableName: {
 											Type:     schema.TypeString,
@@ -454,7 +454,7 @@ func resourceTopicRule() *schema.Resource {
 										names.AttrRoleARN: {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 									},
 								},
@@ -482,7 +482,7 @@ func resourceTopicRule() *schema.Resource {
 										names.AttrRoleARN: {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 										names.AttrType: {
 											Type:     schema.TypeString,
@@ -510,7 +510,7 @@ func resourceTopicRule() *schema.Resource {
 										names.AttrRoleARN: {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 										"separator": {
 											Type:         schema.TypeString,
@@ -575,7 +575,7 @@ func resourceTopicRule() *schema.Resource {
 										names.AttrRoleARN: {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 									},
 								},
@@ -603,7 +603,7 @@ func resourceTopicRule() *schema.Resource {
 										names.AttrRoleARN: {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 									},
 								},
@@ -670,7 +670,7 @@ func resourceTopicRule() *schema.Resource {
 										names.AttrRoleARN: {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 										"stream_name": {
 											Type:     schema.TypeString,
@@ -710,7 +710,7 @@ func resourceTopicRule() *schema.Resource {
 										names.AttrRoleARN: {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 										"topic": {
 											Type:     schema.TypeString,
@@ -742,7 +742,7 @@ func resourceTopicRule() *schema.Resource {
 										names.AttrRoleARN: {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 									},
 								},
@@ -762,7 +762,7 @@ func resourceTopicRule() *schema.Resource {
 										names.AttrRoleARN: {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 										names.AttrTargetARN: {
 											Type:         schema.TypeString,
@@ -786,7 +786,7 @@ func resourceTopicRule() *schema.Resource {
 										names.AttrRoleARN: {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 										"use_base64": {
 											Type:     schema.TypeBool,
@@ -809,7 +809,7 @@ func resourceTopicRule() *schema.Resource {
 										names.AttrRoleARN: {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 										"state_machine_name": {
 											Type:     schema.TypeString,
@@ -837,7 +837,7 @@ func resourceTopicRule() *schema.Resource {
 										names.AttrRoleARN: {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 										names.AttrTableName: {
 											Type:     schema.TypeString,
@@ -890,7 +890,7 @@ func resourceTopicRule() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							"separator": {
 								Type:         schema.TypeString,
@@ -951,7 +951,7 @@ func resourceTopicRule() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 						},
 					},
@@ -977,7 +977,7 @@ func resourceTopicRule() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 						},
 					},
@@ -1040,7 +1040,7 @@ func resourceTopicRule() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							"stream_name": {
 								Type:     schema.TypeString,
@@ -1082,7 +1082,7 @@ func resourceTopicRule() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							"topic": {
 								Type:     schema.TypeString,
@@ -1112,7 +1112,7 @@ func resourceTopicRule() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 						},
 					},
@@ -1130,7 +1130,7 @@ func resourceTopicRule() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							names.AttrTargetARN: {
 								Type:         schema.TypeString,
@@ -1160,7 +1160,7 @@ func resourceTopicRule() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							"use_base64": {
 								Type:     schema.TypeBool,
@@ -1181,7 +1181,7 @@ func resourceTopicRule() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							"state_machine_name": {
 								Type:     schema.TypeString,
@@ -1209,7 +1209,7 @@ func resourceTopicRule() *schema.Resource {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 							names.AttrTableName: {
 								Type:     schema.TypeString,
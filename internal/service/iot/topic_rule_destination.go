@@ -64,7 +64,7 @@ func resourceTopicRuleDestination() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidIAMRoleARN,
 						},
 						names.AttrSecurityGroups: {
 							Type:     schema.TypeSet,
@@ -81,7 +81,7 @@ func resourceCustomPlugin() *schema.Resource {
 										Type:         schema.TypeString,
 										Required:     true,
 										ForceNew:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidS3BucketARN,
 									},
 									"file_key": {
 										Type:     schema.TypeString,
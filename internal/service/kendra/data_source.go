@@ -397,7 +397,7 @@ func ResourceDataSource() *schema.Resource {
 						names.AttrRoleARN: {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidIAMRoleARN,
 						},
 					},
 				},
@@ -454,7 +454,7 @@ func ResourceDataSource() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			names.AttrSchedule: {
 				Type:     schema.TypeString,
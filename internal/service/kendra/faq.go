@@ -113,7 +113,7 @@ func ResourceFaq() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"s3_path": {
 				Type:     schema.TypeList,
@@ -249,7 +249,7 @@ func ResourceIndex() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"server_side_encryption_configuration": {
 				Type:     schema.TypeList,
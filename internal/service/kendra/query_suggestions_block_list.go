@@ -72,7 +72,7 @@ func ResourceQuerySuggestionsBlockList() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"source_s3_path": {
 				Type:     schema.TypeList,
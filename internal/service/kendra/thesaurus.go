@@ -68,7 +68,7 @@ func ResourceThesaurus() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"source_s3_path": {
 				Type:     schema.TypeList,
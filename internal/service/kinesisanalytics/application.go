@@ -74,7 +74,7 @@ func resourceApplication() *schema.Resource {
 						names.AttrRoleARN: {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidIAMRoleARN,
 						},
 					},
 				},
@@ -118,7 +118,7 @@ func resourceApplication() *schema.Resource {
 									names.AttrRoleARN: {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidIAMRoleARN,
 									},
 								},
 							},
@@ -137,7 +137,7 @@ func resourceApplication() *schema.Resource {
 									names.AttrRoleARN: {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidIAMRoleARN,
 									},
 								},
 							},
@@ -187,7 +187,7 @@ func resourceApplication() *schema.Resource {
 												names.AttrRoleARN: {
 													Type:         schema.TypeString,
 													Required:     true,
-													ValidateFunc: verify.ValidARN,
+													ValidateFunc: verify.ValidIAMRoleARN,
 												},
 											},
 										},
@@ -350,7 +350,7 @@ func resourceApplication() *schema.Resource {
 									names.AttrRoleARN: {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidIAMRoleARN,
 									},
 								},
 							},
@@ -369,7 +369,7 @@ func resourceApplication() *schema.Resource {
 									names.AttrRoleARN: {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidIAMRoleARN,
 									},
 								},
 							},
@@ -388,7 +388,7 @@ func resourceApplication() *schema.Resource {
 									names.AttrRoleARN: {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidIAMRoleARN,
 									},
 								},
 							},
@@ -437,7 +437,7 @@ func resourceApplication() *schema.Resource {
 									"bucket_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidS3BucketARN,
 									},
 									"file_key": {
 										Type:     schema.TypeString,
@@ -446,7 +446,7 @@ func resourceApplication() *schema.Resource {
 									names.AttrRoleARN: {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidIAMRoleARN,
 									},
 								},
 							},
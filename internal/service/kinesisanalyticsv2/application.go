@@ -89,7 +89,7 @@ func resourceApplication() *schema.Resource {
 																"bucket_arn": {
 																	Type:         schema.TypeString,
 																	Required:     true,
-																	ValidateFunc: verify.ValidARN,
+																	ValidateFunc: verify.ValidS3BucketARN,
 																},
 																"file_key": {
 																	Type:         schema.TypeString,
@@ -726,7 +726,7 @@ func resourceApplication() *schema.Resource {
 																"bucket_arn": {
 																	Type:         schema.TypeString,
 																	Required:     true,
-																	ValidateFunc: verify.ValidARN,
+																	ValidateFunc: verify.ValidS3BucketARN,
 																},
 																"file_key": {
 																	Type:     schema.TypeString,
@@ -51,7 +51,7 @@ func ResourceResource() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"use_service_linked_role": {
 				Type:     schema.TypeBool,
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+var (
+	// deploymentPackageModified is the fixed modification time of every deployment package entry.
+	// It is the earliest time representable in the MS-DOS date format used by ZIP files.
+	deploymentPackageModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// deploymentPackage is a ZIP archive built from a source directory.
+type deploymentPackage struct {
+	content   []byte
+	fileCount int
+}
+
+// sourceCodeHash returns the Base64-encoded SHA-256 hash of the package, as reported by Lambda in CodeSha256.
+func (p *deploymentPackage) sourceCodeHash() string {
+	hash := sha256.Sum256(p.content)
+	return itypes.Base64Encode(hash[:])
+}
+
+// buildDeploymentPackage builds a byte-for-byte reproducible ZIP archive from the files in sourceDir.
+// Entries are sorted by path, every entry has the same modification time and permissions are normalized to 0644, or 0755 for executable files.
+func buildDeploymentPackage(sourceDir string, includes, excludes []string) (*deploymentPackage, error) {
+	dir, err := homedir.Expand(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := tfio.ListFiles(dir, includes, excludes)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, errors.New("no files to package")
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	for _, name := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+
+		header := &zip.FileHeader{
+			Name:     name,
+			Method:   zip.Deflate,
+			Modified: deploymentPackageModified,
+		}
+		header.SetMode(deploymentPackageFileMode(fi.Mode()))
+
+		fw, err := w.CreateHeader(header)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := fw.Write(content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return &deploymentPackage{
+		content:   buf.Bytes(),
+		fileCount: len(files),
+	}, nil
+}
+
+func deploymentPackageFileMode(mode fs.FileMode) fs.FileMode {
+	if mode.Perm()&0111 != 0 {
+		return 0755
+	}
+
+	return 0644
+}
+
+// uploadDeploymentPackage uploads the deployment package to S3 unless the object already has the same SHA-256 checksum.
+// It returns the version ID of the object.
+func uploadDeploymentPackage(ctx context.Context, conn *s3.Client, bucket, key string, pkg *deploymentPackage) (string, error) {
+	checksum := pkg.sourceCodeHash()
+
+	output, err := conn.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket:       aws.String(bucket),
+		ChecksumMode: s3types.ChecksumModeEnabled,
+		Key:          aws.String(key),
+	})
+
+	switch {
+	case tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound):
+	case err != nil:
+		return "", err
+	case aws.ToString(output.ChecksumSHA256) == checksum:
+		return aws.ToString(output.VersionId), nil
+	}
+
+	input := &s3.PutObjectInput{
+		Body:              bytes.NewReader(pkg.content),
+		Bucket:            aws.String(bucket),
+		ChecksumAlgorithm: s3types.ChecksumAlgorithmSha256,
+		ChecksumSHA256:    aws.String(checksum),
+		ContentType:       aws.String("application/zip"),
+		Key:               aws.String(key),
+	}
+
+	putOutput, err := conn.PutObject(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(putOutput.VersionId), nil
+}
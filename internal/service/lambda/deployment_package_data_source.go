@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_lambda_deployment_package", name="Deployment Package")
+func dataSourceDeploymentPackage() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDeploymentPackageRead,
+
+		Schema: map[string]*schema.Schema{
+			"excludes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidGlob,
+				},
+			},
+			"file_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"includes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidGlob,
+				},
+			},
+			"source_code_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_code_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceDeploymentPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Grab an exclusive lock so that we're only reading one deployment package into memory at a time.
+	// See https://github.com/hashicorp/terraform/issues/9364.
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	sourceDir := d.Get("source_dir").(string)
+	pkg, err := buildDeploymentPackage(sourceDir, flex.ExpandStringValueSet(d.Get("includes").(*schema.Set)), flex.ExpandStringValueSet(d.Get("excludes").(*schema.Set)))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "building Lambda deployment package (%s): %s", sourceDir, err)
+	}
+
+	sourceCodeHash := pkg.sourceCodeHash()
+
+	d.SetId(sourceCodeHash)
+	d.Set("file_count", pkg.fileCount)
+	d.Set("source_code_hash", sourceCodeHash)
+	d.Set("source_code_size", len(pkg.content))
+
+	return diags
+}
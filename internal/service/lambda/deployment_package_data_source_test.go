@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaDeploymentPackageDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_lambda_deployment_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentPackageDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "file_count", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "source_code_hash"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "source_code_size"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "source_code_hash", dataSourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "source_code_hash", "data.aws_lambda_deployment_package.includes", "source_code_hash"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaDeploymentPackageDataSource_noFiles(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeploymentPackageDataSourceConfig_noFiles,
+				ExpectError: regexache.MustCompile(`no files to package`),
+			},
+		},
+	})
+}
+
+const testAccDeploymentPackageDataSourceConfig_basic = `
+data "aws_lambda_deployment_package" "test" {
+  source_dir = "test-fixtures/deployment_package"
+  excludes   = ["*.md"]
+}
+
+data "aws_lambda_deployment_package" "includes" {
+  source_dir = "test-fixtures/deployment_package"
+  includes   = ["**/*.js"]
+}
+`
+
+const testAccDeploymentPackageDataSourceConfig_noFiles = `
+data "aws_lambda_deployment_package" "test" {
+  source_dir = "test-fixtures/deployment_package"
+  includes   = ["*.py"]
+}
+`
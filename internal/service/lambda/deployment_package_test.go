@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestBuildDeploymentPackage(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]fs.FileMode{
+		"index.py":            0600,
+		"bootstrap":           0700,
+		"lib/util.py":         0640,
+		"lib/util_test.py":    0644,
+		"__pycache__/a.pyc":   0644,
+		"lib/nested/data.txt": 0666,
+	}
+	for name, mode := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(name), mode); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chmod(p, mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	excludes := []string{"__pycache__", "**/*_test.py"}
+	pkg, err := buildDeploymentPackage(dir, nil, excludes)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(pkg.content), int64(len(pkg.content)))
+	if err != nil {
+		t.Fatalf("reading package: %s", err)
+	}
+
+	var got []string
+	for _, f := range r.File {
+		got = append(got, f.Name)
+
+		if !f.Modified.Equal(time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("%s: modified = %s", f.Name, f.Modified)
+		}
+
+		expectedMode := fs.FileMode(0644)
+		if f.Name == "bootstrap" {
+			expectedMode = 0755
+		}
+		if f.Mode() != expectedMode {
+			t.Errorf("%s: mode = %s, want %s", f.Name, f.Mode(), expectedMode)
+		}
+	}
+
+	if expected := []string{"bootstrap", "index.py", "lib/nested/data.txt", "lib/util.py"}; !slices.Equal(got, expected) {
+		t.Errorf("entries = %v, want %v", got, expected)
+	}
+	if pkg.fileCount != len(got) {
+		t.Errorf("fileCount = %d, want %d", pkg.fileCount, len(got))
+	}
+
+	// Modification times must not affect the package.
+	future := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "index.py"), future, future); err != nil {
+		t.Fatal(err)
+	}
+
+	again, err := buildDeploymentPackage(dir, nil, excludes)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !bytes.Equal(pkg.content, again.content) {
+		t.Error("package is not reproducible")
+	}
+	if pkg.sourceCodeHash() != again.sourceCodeHash() {
+		t.Errorf("sourceCodeHash = %s, want %s", again.sourceCodeHash(), pkg.sourceCodeHash())
+	}
+
+	// Content changes must change the hash.
+	if err := os.WriteFile(filepath.Join(dir, "index.py"), []byte("changed"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	changed, err := buildDeploymentPackage(dir, nil, excludes)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if pkg.sourceCodeHash() == changed.sourceCodeHash() {
+		t.Error("sourceCodeHash did not change")
+	}
+}
+
+func TestBuildDeploymentPackage_noFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "index.py"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := buildDeploymentPackage(dir, []string{"*.js"}, nil); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -172,9 +172,10 @@ func resourceFunction() *schema.Resource {
 				},
 			},
 			"filename": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				Type:          schema.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
+				ConflictsWith: []string{"image_uri", names.AttrS3Bucket, "source_dir"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -211,9 +212,10 @@ func resourceFunction() *schema.Resource {
 				},
 			},
 			"image_uri": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				Type:          schema.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
+				ConflictsWith: []string{"filename", names.AttrS3Bucket, "source_dir"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -325,10 +327,11 @@ func resourceFunction() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[awstypes.Runtime](),
 			},
 			names.AttrS3Bucket: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
-				RequiredWith: []string{"s3_key"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
+				ConflictsWith: []string{"filename", "image_uri"},
+				RequiredWith:  []string{"s3_key"},
 			},
 			"s3_key": {
 				Type:         schema.TypeString,
@@ -338,7 +341,7 @@ func resourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -376,11 +379,36 @@ func resourceFunction() *schema.Resource {
 				Optional:         true,
 				Computed:         true,
 				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				ConflictsWith:    []string{"source_dir"},
 			},
 			"source_code_size": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
+				ConflictsWith: []string{"filename", "image_uri"},
+			},
+			"source_dir_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				RequiredWith: []string{"source_dir"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidGlob,
+				},
+			},
+			"source_dir_includes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				RequiredWith: []string{"source_dir"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidGlob,
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrTimeout: {
@@ -459,6 +487,7 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			updateSourceCodeHashForSourceDir,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -496,6 +525,17 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		input.Code.ZipFile = zipFile
+	} else if _, ok := d.GetOk("source_dir"); ok {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		code, err := expandFunctionCodeFromSourceDir(ctx, d, meta)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Code = code
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
 	} else {
@@ -962,6 +1002,20 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 
 			input.ZipFile = zipFile
+		} else if _, ok := d.GetOk("source_dir"); ok {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			code, err := expandFunctionCodeFromSourceDir(ctx, d, meta)
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			input.ZipFile = code.ZipFile
+			input.S3Bucket = code.S3Bucket
+			input.S3Key = code.S3Key
+			input.S3ObjectVersion = code.S3ObjectVersion
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
 		} else {
@@ -1334,6 +1388,64 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// expandFunctionCodeFromSourceDir builds the deployment package from source_dir.
+// If s3_bucket is set, the package is uploaded to S3 unless an identical object already exists and the function code refers to the S3 object.
+func expandFunctionCodeFromSourceDir(ctx context.Context, d *schema.ResourceData, meta interface{}) (*awstypes.FunctionCode, error) {
+	sourceDir := d.Get("source_dir").(string)
+	pkg, err := buildDeploymentPackage(sourceDir, flex.ExpandStringValueSet(d.Get("source_dir_includes").(*schema.Set)), flex.ExpandStringValueSet(d.Get("source_dir_excludes").(*schema.Set)))
+
+	if err != nil {
+		return nil, fmt.Errorf("building deployment package (%s): %w", sourceDir, err)
+	}
+
+	v, ok := d.GetOk(names.AttrS3Bucket)
+	if !ok {
+		return &awstypes.FunctionCode{
+			ZipFile: pkg.content,
+		}, nil
+	}
+
+	bucket, key := v.(string), d.Get("s3_key").(string)
+	versionID, err := uploadDeploymentPackage(ctx, meta.(*conns.AWSClient).S3Client(ctx), bucket, key, pkg)
+
+	if err != nil {
+		return nil, fmt.Errorf("uploading deployment package to S3 (%s/%s): %w", bucket, key, err)
+	}
+
+	code := &awstypes.FunctionCode{
+		S3Bucket: aws.String(bucket),
+		S3Key:    aws.String(key),
+	}
+	if versionID != "" {
+		code.S3ObjectVersion = aws.String(versionID)
+	}
+
+	return code, nil
+}
+
+// updateSourceCodeHashForSourceDir plans a code update when the deployment package built from source_dir changes.
+func updateSourceCodeHashForSourceDir(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("source_dir")
+	if !ok {
+		return nil
+	}
+
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	pkg, err := buildDeploymentPackage(v.(string), flex.ExpandStringValueSet(d.Get("source_dir_includes").(*schema.Set)), flex.ExpandStringValueSet(d.Get("source_dir_excludes").(*schema.Set)))
+
+	if err != nil {
+		return fmt.Errorf("building deployment package (%s): %w", v, err)
+	}
+
+	if hash := pkg.sourceCodeHash(); hash != d.Get("source_code_hash").(string) {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
@@ -1354,6 +1466,7 @@ func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff
 func needsFunctionCodeUpdate(d sdkv2.ResourceDiffer) bool {
 	return d.HasChange("filename") ||
 		d.HasChange("source_code_hash") ||
+		d.HasChange("source_dir") ||
 		d.HasChange(names.AttrS3Bucket) ||
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
@@ -92,6 +92,79 @@ func TestAccLambdaFunction_basic(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	resourceName := "aws_lambda_function.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_sourceDir(rName, "*.md"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+					resource.TestCheckResourceAttr(resourceName, "source_dir", "test-fixtures/deployment_package"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "source_code_hash", "source_dir", "source_dir_excludes"},
+			},
+			{
+				Config: testAccFunctionConfig_sourceDir(rName, "lib/**"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunction_sourceDirS3(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	resourceName := "aws_lambda_function.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_sourceDirS3(rName, "*.md"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrS3Bucket, "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "s3_key", "lambda/package.zip"),
+				),
+			},
+			{
+				Config:   testAccFunctionConfig_sourceDirS3(rName, "*.md"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccFunctionConfig_sourceDirS3(rName, "lib/**"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var function lambda.GetFunctionOutput
@@ -2552,6 +2625,43 @@ resource "aws_lambda_function" "test" {
 `, funcName))
 }
 
+func testAccFunctionConfig_sourceDir(rName, exclude string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  source_dir          = "test-fixtures/deployment_package"
+  source_dir_excludes = [%[2]q]
+  function_name       = %[1]q
+  role                = aws_iam_role.iam_for_lambda.arn
+  handler             = "index.handler"
+  runtime             = "nodejs20.x"
+}
+`, rName, exclude))
+}
+
+func testAccFunctionConfig_sourceDirS3(rName, exclude string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_lambda_function" "test" {
+  source_dir          = "test-fixtures/deployment_package"
+  source_dir_excludes = [%[2]q]
+  s3_bucket           = aws_s3_bucket.test.bucket
+  s3_key              = "lambda/package.zip"
+  function_name       = %[1]q
+  role                = aws_iam_role.iam_for_lambda.arn
+  handler             = "index.handler"
+  runtime             = "nodejs20.x"
+}
+`, rName, exclude))
+}
+
 func testAccFunctionConfig_snapStartEnabled(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaPermissionsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_permissions_exclusive.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", functionResourceName, "function_name"),
+					resource.TestCheckNoResourceAttr(resourceName, "qualifier"),
+					resource.TestCheckResourceAttr(resourceName, "statement_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "statement_ids.*", "AllowExecutionFromCloudWatch"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "function_name"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "function_name",
+			},
+		},
+	})
+}
+
+// A permission added out of band should be removed
+func TestAccLambdaPermissionsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_permissions_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					testAccCheckPermissionsExclusiveAddPermission(ctx, rName, "OutOfBand"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "statement_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPermissionsExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return create.Error(names.Lambda, create.ErrActionCheckingExistence, tflambda.ResNamePermissionsExclusive, n, errors.New("not found"))
+		}
+
+		functionName := rs.Primary.Attributes["function_name"]
+		if functionName == "" {
+			return create.Error(names.Lambda, create.ErrActionCheckingExistence, tflambda.ResNamePermissionsExclusive, n, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+		out, err := tflambda.FindPolicyStatementIDsByTwoPartKey(ctx, conn, functionName, rs.Primary.Attributes["qualifier"])
+		if err != nil {
+			return create.Error(names.Lambda, create.ErrActionCheckingExistence, tflambda.ResNamePermissionsExclusive, functionName, err)
+		}
+
+		if rs.Primary.Attributes["statement_ids.#"] != strconv.Itoa(len(out)) {
+			return create.Error(names.Lambda, create.ErrActionCheckingExistence, tflambda.ResNamePermissionsExclusive, functionName, errors.New("unexpected statement_ids count"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPermissionsExclusiveAddPermission(ctx context.Context, functionName, statementID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		_, err := conn.AddPermission(ctx, &lambda.AddPermissionInput{
+			Action:       aws.String("lambda:InvokeFunction"),
+			FunctionName: aws.String(functionName),
+			Principal:    aws.String("sns.amazonaws.com"),
+			StatementId:  aws.String(statementID),
+		})
+
+		return err
+	}
+}
+
+func testAccPermissionsExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), `
+resource "aws_lambda_permission" "test" {
+  statement_id  = "AllowExecutionFromCloudWatch"
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "events.amazonaws.com"
+}
+
+resource "aws_lambda_permissions_exclusive" "test" {
+  function_name = aws_lambda_function.test.function_name
+  statement_ids = [aws_lambda_permission.test.statement_id]
+}
+`)
+}
@@ -35,6 +35,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_lambda_function_recursion_config",
 			Name:     "Function Recursion Config",
 		},
+		{
+			Factory:  newResourcePermissionsExclusive,
+			TypeName: "aws_lambda_permissions_exclusive",
+			Name:     "Permissions Exclusive",
+		},
 		{
 			Factory:  newResourceRuntimeManagementConfig,
 			TypeName: "aws_lambda_runtime_management_config",
@@ -55,6 +60,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_lambda_code_signing_config",
 			Name:     "Code Signing Config",
 		},
+		{
+			Factory:  dataSourceDeploymentPackage,
+			TypeName: "aws_lambda_deployment_package",
+			Name:     "Deployment Package",
+		},
 		{
 			Factory:  dataSourceFunction,
 			TypeName: "aws_lambda_function",
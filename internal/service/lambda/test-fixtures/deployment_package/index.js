@@ -0,0 +1,3 @@
+exports.handler = async (event) => {
+  return require("./lib/greeting").greet(event.name);
+};
@@ -15,9 +15,11 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/hashicorp/go-version"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -333,6 +335,45 @@ func testAccDatabase_masterPassword(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
+func testAccDatabase_masterPasswordWriteOnly(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lightsail_database.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckLightsailSynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck: acctest.ErrorCheck(t, strings.ToLower(lightsail.ServiceID)),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatabaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfig_masterPasswordWriteOnly(rName, "testpassword1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatabaseExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccDatabaseConfig_masterPasswordWriteOnly(rName, "testpassword2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatabaseExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDatabase_preferredBackupWindow(t *testing.T, semaphore tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -973,6 +1014,24 @@ resource "aws_lightsail_database" "test" {
 `, rName, masterPassword))
 }
 
+func testAccDatabaseConfig_masterPasswordWriteOnly(rName, masterPassword string, masterPasswordVersion int) string {
+	return acctest.ConfigCompose(
+		testAccDatabaseConfig_base(),
+		fmt.Sprintf(`
+resource "aws_lightsail_database" "test" {
+  relational_database_name   = %[1]q
+  availability_zone          = data.aws_availability_zones.available.names[0]
+  master_database_name       = "testdatabasename"
+  master_password_wo         = %[2]q
+  master_password_wo_version = %[3]d
+  master_username            = "testusername"
+  blueprint_id               = "mysql_8_0"
+  bundle_id                  = "micro_2_0"
+  skip_final_snapshot        = true
+}
+`, rName, masterPassword, masterPasswordVersion))
+}
+
 func testAccDatabaseConfig_preferredBackupWindow(rName, preferredBackupWindow string) string {
 	return acctest.ConfigCompose(
 		testAccDatabaseConfig_base(),
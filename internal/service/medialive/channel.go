@@ -683,7 +683,7 @@ func ResourceChannel() *schema.Resource {
 				names.AttrRoleARN: {
 					Type:             schema.TypeString,
 					Optional:         true,
-					ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+					ValidateDiagFunc: validation.ToDiagFunc(verify.ValidIAMRoleARN),
 				},
 				"start_channel": {
 					Type:     schema.TypeBool,
@@ -123,7 +123,7 @@ func ResourceInput() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+				ValidateDiagFunc: validation.ToDiagFunc(verify.ValidIAMRoleARN),
 			},
 			"sources": {
 				Type:     schema.TypeSet,
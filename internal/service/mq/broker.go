@@ -147,7 +147,7 @@ func resourceBroker() *schema.Resource {
 							Optional:     true,
 							Computed:     true,
 							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidKMSKeyARN,
 						},
 						"use_aws_owned_key": {
 							Type:     schema.TypeBool,
@@ -355,10 +355,21 @@ func resourceBroker() *schema.Resource {
 						},
 						names.AttrPassword: {
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: ValidBrokerPassword,
+						},
+						"password_wo": {
+							Type:         schema.TypeString,
+							Optional:     true,
 							Sensitive:    true,
+							WriteOnly:    true,
 							ValidateFunc: ValidBrokerPassword,
 						},
+						"password_wo_version": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
 						"replication_user": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -376,6 +387,7 @@ func resourceBroker() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
+			validateUserPasswords,
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				if strings.EqualFold(diff.Get("engine_type").(string), string(types.EngineTypeRabbitmq)) {
 					if v, ok := diff.GetOk("logs.0.audit"); ok {
@@ -396,6 +408,9 @@ func resourceBrokerCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	conn := meta.(*conns.AWSClient).MQClient(ctx)
 
+	users := d.Get("user").(*schema.Set).List()
+	expandUserWriteOnlyPasswords(d, nil, users)
+
 	name := d.Get("broker_name").(string)
 	engineType := d.Get("engine_type").(string)
 	input := &mq.CreateBrokerInput{
@@ -407,7 +422,7 @@ func resourceBrokerCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		HostInstanceType:        aws.String(d.Get("host_instance_type").(string)),
 		PubliclyAccessible:      aws.Bool(d.Get(names.AttrPubliclyAccessible).(bool)),
 		Tags:                    getTagsIn(ctx),
-		Users:                   expandUsers(d.Get("user").(*schema.Set).List()),
+		Users:                   expandUsers(users),
 	}
 
 	if v, ok := d.GetOk("authentication_strategy"); ok {
@@ -579,11 +594,14 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	if d.HasChange("user") {
 		o, n := d.GetChange("user")
+		oldUsers, newUsers := o.(*schema.Set).List(), n.(*schema.Set).List()
+		expandUserWriteOnlyPasswords(d, oldUsers, newUsers)
+
 		var err error
 		// d.HasChange("user") always reports a change when running resourceBrokerUpdate
 		// updateBrokerUsers needs to be called to know if changes to user are actually made
 		var usersUpdated bool
-		usersUpdated, err = updateBrokerUsers(ctx, conn, d.Id(), o.(*schema.Set).List(), n.(*schema.Set).List())
+		usersUpdated, err = updateBrokerUsers(ctx, conn, d.Id(), oldUsers, newUsers)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating MQ Broker (%s) users: %s", d.Id(), err)
@@ -801,6 +819,9 @@ func resourceUserHash(v interface{}) int {
 	if p, ok := m[names.AttrPassword]; ok {
 		buf.WriteString(fmt.Sprintf("%s-", p.(string)))
 	}
+	if v, ok := m["password_wo_version"].(int); ok && v != 0 {
+		buf.WriteString(fmt.Sprintf("%d-", v))
+	}
 	buf.WriteString(fmt.Sprintf("%s-", m[names.AttrUsername].(string)))
 
 	return create.StringHashcode(buf.String())
@@ -880,14 +901,18 @@ func DiffBrokerUsers(bId string, oldUsers, newUsers []interface{}) (cr []*mq.Cre
 			existingUserMap := eu.(map[string]interface{})
 
 			if !reflect.DeepEqual(existingUserMap, newUserMap) {
-				ur = append(ur, &mq.UpdateUserInput{
+				uur := &mq.UpdateUserInput{
 					BrokerId:        aws.String(bId),
 					ConsoleAccess:   aws.Bool(newUserMap["console_access"].(bool)),
 					Groups:          flex.ExpandStringValueList(ng),
 					ReplicationUser: aws.Bool(newUserMap["replication_user"].(bool)),
-					Password:        aws.String(newUserMap[names.AttrPassword].(string)),
 					Username:        aws.String(username),
-				})
+				}
+				// A user with a write-only password only has a password if its password_wo_version has changed.
+				if v := newUserMap[names.AttrPassword].(string); v != "" {
+					uur.Password = aws.String(v)
+				}
+				ur = append(ur, uur)
 			}
 
 			// Delete after processing, so we know what's left for deletion
@@ -1029,6 +1054,76 @@ func expandUsers(cfg []interface{}) []types.User {
 	return users
 }
 
+// validateUserPasswords checks at plan time that every configured user has either a password or a write-only password.
+func validateUserPasswords(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	config := diff.GetRawConfig()
+	if !config.IsKnown() || config.IsNull() {
+		return nil
+	}
+
+	users := config.GetAttr("user")
+	if !users.IsKnown() || users.IsNull() {
+		return nil
+	}
+
+	var errs []error
+	for it := users.ElementIterator(); it.Next(); {
+		_, user := it.Element()
+		username, password, passwordWO := user.GetAttr(names.AttrUsername), user.GetAttr(names.AttrPassword), user.GetAttr("password_wo")
+		if !username.IsKnown() || !password.IsKnown() || !passwordWO.IsKnown() {
+			continue
+		}
+
+		if (password.IsNull() || password.AsString() == "") && passwordWO.IsNull() {
+			errs = append(errs, fmt.Errorf(`MQ Broker user (%s): one of "password" or "password_wo" must be set`, username.AsString()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// expandUserWriteOnlyPasswords sets the password of each configured user from its write-only password.
+// Users that already had a write-only password in oldUsers only have their password set if their password_wo_version has changed.
+func expandUserWriteOnlyPasswords(d *schema.ResourceData, oldUsers, newUsers []interface{}) {
+	passwords := make(map[string]string)
+	if config := d.GetRawConfig(); config.IsKnown() && !config.IsNull() {
+		if users := config.GetAttr("user"); users.IsKnown() && !users.IsNull() {
+			for it := users.ElementIterator(); it.Next(); {
+				_, user := it.Element()
+				username, password := user.GetAttr(names.AttrUsername), user.GetAttr("password_wo")
+				if username.IsKnown() && !username.IsNull() && password.IsKnown() && !password.IsNull() {
+					passwords[username.AsString()] = password.AsString()
+				}
+			}
+		}
+	}
+
+	// A user with a password in state is switching from password to password_wo, so its password is always sent.
+	versions := make(map[string]int)
+	for _, v := range oldUsers {
+		m := v.(map[string]interface{})
+		if m[names.AttrPassword].(string) == "" {
+			versions[m[names.AttrUsername].(string)] = m["password_wo_version"].(int)
+		}
+	}
+
+	for _, v := range newUsers {
+		m := v.(map[string]interface{})
+		username := m[names.AttrUsername].(string)
+
+		password, ok := passwords[username]
+		if !ok {
+			continue
+		}
+
+		if version, ok := versions[username]; ok && version == m["password_wo_version"].(int) {
+			continue
+		}
+
+		m[names.AttrPassword] = password
+	}
+}
+
 func expandUsersForBroker(ctx context.Context, conn *mq.Client, brokerId string, input []types.UserSummary) ([]*types.User, error) {
 	var rawUsers []*types.User
 
@@ -1058,10 +1153,14 @@ func expandUsersForBroker(ctx context.Context, conn *mq.Client, brokerId string,
 // We use cfgdUsers to get & set the password
 func flattenUsers(users []*types.User, cfgUsers []interface{}) *schema.Set {
 	existingPairs := make(map[string]string)
+	existingVersions := make(map[string]int)
 	for _, u := range cfgUsers {
 		user := u.(map[string]interface{})
 		username := user[names.AttrUsername].(string)
 		existingPairs[username] = user[names.AttrPassword].(string)
+		if v, ok := user["password_wo_version"].(int); ok {
+			existingVersions[username] = v
+		}
 	}
 
 	out := make([]interface{}, 0)
@@ -1076,6 +1175,9 @@ func flattenUsers(users []*types.User, cfgUsers []interface{}) *schema.Set {
 		if password != "" {
 			m[names.AttrPassword] = password
 		}
+		if v := existingVersions[aws.ToString(u.Username)]; v != 0 {
+			m["password_wo_version"] = v
+		}
 		if u.ConsoleAccess != nil {
 			m["console_access"] = aws.ToBool(u.ConsoleAccess)
 		}
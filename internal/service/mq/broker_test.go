@@ -16,10 +16,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/mq/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfmq "github.com/hashicorp/terraform-provider-aws/internal/service/mq"
@@ -215,6 +217,38 @@ func TestDiffUsers(t *testing.T) {
 				},
 			},
 		},
+		{
+			OldUsers: []interface{}{
+				map[string]interface{}{
+					"console_access":      false,
+					names.AttrUsername:    "first",
+					names.AttrPassword:    "",
+					"password_wo_version": 1,
+					"replication_user":    false,
+				},
+			},
+			NewUsers: []interface{}{
+				map[string]interface{}{
+					"console_access":      false,
+					names.AttrUsername:    "first",
+					names.AttrPassword:    "",
+					"password_wo_version": 1,
+					"groups":              schema.NewSet(schema.HashString, []interface{}{"admin"}),
+					"replication_user":    false,
+				},
+			},
+			Creations: nil,
+			Deletions: nil,
+			Updates: []*mq.UpdateUserInput{
+				{
+					BrokerId:        aws.String("test"),
+					ConsoleAccess:   aws.Bool(false),
+					Username:        aws.String("first"),
+					Groups:          []string{"admin"},
+					ReplicationUser: aws.Bool(false),
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1061,6 +1095,85 @@ func TestAccMQBroker_Update_users(t *testing.T) {
 	})
 }
 
+func TestAccMQBroker_Update_usersPasswordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck: acctest.ErrorCheck(t, names.MQServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_usersPassword(rName, testAccBrokerVersionNewer, "TestTest1111"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "user.#", "1"),
+				),
+			},
+			{
+				Config: testAccBrokerConfig_updateUsersPasswordWriteOnly(rName, testAccBrokerVersionNewer, "TestTest1111", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "user.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						names.AttrUsername:    "first",
+						"password_wo_version": "1",
+					}),
+				),
+			},
+			{
+				Config: testAccBrokerConfig_updateUsersPasswordWriteOnly(rName, testAccBrokerVersionNewer, "TestTest1111updated", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "user.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						names.AttrUsername:    "first",
+						"password_wo_version": "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_usersPasswordMissing(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBrokerConfig_usersPasswordMissing(rName, testAccBrokerVersionNewer),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`one of "password" or "password_wo" must be set`),
+			},
+		},
+	})
+}
+
 func TestAccMQBroker_Update_securityGroup(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2326,6 +2439,75 @@ resource "aws_mq_broker" "test" {
 `, rName, version)
 }
 
+func testAccBrokerConfig_updateUsersPasswordWriteOnly(rName, version, password string, passwordVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_mq_broker" "test" {
+  apply_immediately  = true
+  broker_name        = %[1]q
+  engine_type        = "ActiveMQ"
+  engine_version     = %[2]q
+  host_instance_type = "mq.t2.micro"
+  security_groups    = [aws_security_group.test.id]
+
+  user {
+    username            = "first"
+    password_wo         = %[3]q
+    password_wo_version = %[4]d
+  }
+}
+`, rName, version, password, passwordVersion)
+}
+
+func testAccBrokerConfig_usersPassword(rName, version, password string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_mq_broker" "test" {
+  apply_immediately  = true
+  broker_name        = %[1]q
+  engine_type        = "ActiveMQ"
+  engine_version     = %[2]q
+  host_instance_type = "mq.t2.micro"
+  security_groups    = [aws_security_group.test.id]
+
+  user {
+    username = "first"
+    password = %[3]q
+  }
+}
+`, rName, version, password)
+}
+
+func testAccBrokerConfig_usersPasswordMissing(rName, version string) string {
+	return fmt.Sprintf(`
+resource "aws_mq_broker" "test" {
+  apply_immediately  = true
+  broker_name        = %[1]q
+  engine_type        = "ActiveMQ"
+  engine_version     = %[2]q
+  host_instance_type = "mq.t2.micro"
+
+  user {
+    username = "first"
+  }
+}
+`, rName, version)
+}
+
 func testAccBrokerConfig_updateUsersSecurityGroups(rName, version string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
@@ -95,7 +95,7 @@ func resourceEnvironment() *schema.Resource {
 			names.AttrExecutionRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			names.AttrKMSKey: {
 				Type:         schema.TypeString,
@@ -341,7 +341,7 @@ func resourceDomain() *schema.Resource {
 						names.AttrRoleARN: {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidIAMRoleARN,
 						},
 						names.AttrUserPoolID: {
 							Type:     schema.TypeString,
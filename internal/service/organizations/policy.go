@@ -9,9 +9,11 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,12 +21,32 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// serviceControlPolicyValidation and resourceControlPolicyValidation validate service control policies and
+// resource control policies with IAM Access Analyzer. Other policy types are not supported by Access Analyzer.
+var (
+	serviceControlPolicyValidation = &tfaccessanalyzer.PolicyValidation{
+		Attribute:  names.AttrContent,
+		PolicyType: accessanalyzertypes.PolicyTypeServiceControlPolicy,
+		Enabled: func(d interface{ Get(string) interface{} }) bool {
+			return awstypes.PolicyType(d.Get(names.AttrType).(string)) == awstypes.PolicyTypeServiceControlPolicy
+		},
+	}
+	resourceControlPolicyValidation = &tfaccessanalyzer.PolicyValidation{
+		Attribute:  names.AttrContent,
+		PolicyType: accessanalyzertypes.PolicyTypeResourceControlPolicy,
+		Enabled: func(d interface{ Get(string) interface{} }) bool {
+			return awstypes.PolicyType(d.Get(names.AttrType).(string)) == awstypes.PolicyTypeResourceControlPolicy
+		},
+	}
+)
+
 // @SDKResource("aws_organizations_policy", name="Policy")
 // @Tags(identifierAttribute="id")
 func resourcePolicy() *schema.Resource {
@@ -73,7 +95,11 @@ func resourcePolicy() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			serviceControlPolicyValidation.CustomizeDiff,
+			resourceControlPolicyValidation.CustomizeDiff,
+		),
 	}
 }
 
@@ -100,6 +126,9 @@ func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.SetId(aws.ToString(outputRaw.(*organizations.CreatePolicyOutput).Policy.PolicySummary.Id))
 
+	diags = append(diags, serviceControlPolicyValidation.Warnings(ctx, d, meta)...)
+	diags = append(diags, resourceControlPolicyValidation.Warnings(ctx, d, meta)...)
+
 	return append(diags, resourcePolicyRead(ctx, d, meta)...)
 }
 
@@ -167,6 +196,9 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	diags = append(diags, serviceControlPolicyValidation.Warnings(ctx, d, meta)...)
+	diags = append(diags, resourceControlPolicyValidation.Warnings(ctx, d, meta)...)
+
 	return append(diags, resourcePolicyRead(ctx, d, meta)...)
 }
 
@@ -64,7 +64,7 @@ func resourceEmailChannel() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"messages_per_second": {
 				Type:     schema.TypeInt,
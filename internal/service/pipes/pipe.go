@@ -104,7 +104,7 @@ func resourcePipe() *schema.Resource {
 				names.AttrRoleARN: {
 					Type:         schema.TypeString,
 					Required:     true,
-					ValidateFunc: verify.ValidARN,
+					ValidateFunc: verify.ValidIAMRoleARN,
 				},
 				names.AttrSource: {
 					Type:     schema.TypeString,
@@ -169,9 +169,10 @@ func sourceParametersSchema() *schema.Schema {
 								Elem: &schema.Resource{
 									Schema: map[string]*schema.Schema{
 										"pattern": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.StringLenBetween(1, 4096),
+											Type:             schema.TypeString,
+											Required:         true,
+											ValidateFunc:     validation.StringLenBetween(1, 4096),
+											DiffSuppressFunc: verify.SuppressEquivalentEventPatternDiffs,
 										},
 									},
 								},
@@ -407,7 +407,7 @@ func targetParametersSchema() *schema.Schema {
 										names.AttrExecutionRoleARN: {
 											Type:         schema.TypeString,
 											Optional:     true,
-											ValidateFunc: verify.ValidARN,
+											ValidateFunc: verify.ValidIAMRoleARN,
 										},
 										"inference_accelerator_override": {
 											Type:     schema.TypeList,
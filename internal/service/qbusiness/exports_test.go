@@ -4,7 +4,19 @@
 package qbusiness
 
 var (
-	ResourceApplication = newResourceApplication
+	ResourceApplication   = newResourceApplication
+	ResourceDataAccessor  = newResourceDataAccessor
+	ResourceDataSource    = newResourceDataSource
+	ResourceIndex         = newResourceIndex
+	ResourcePlugin        = newResourcePlugin
+	ResourceRetriever     = newResourceRetriever
+	ResourceWebExperience = newResourceWebExperience
 
-	FindApplicationByID = findApplicationByID
+	FindApplicationByID           = findApplicationByID
+	FindDataAccessorByTwoPartKey  = findDataAccessorByTwoPartKey
+	FindDataSourceByThreePartKey  = findDataSourceByThreePartKey
+	FindIndexByTwoPartKey         = findIndexByTwoPartKey
+	FindPluginByTwoPartKey        = findPluginByTwoPartKey
+	FindRetrieverByTwoPartKey     = findRetrieverByTwoPartKey
+	FindWebExperienceByTwoPartKey = findWebExperienceByTwoPartKey
 )
@@ -91,7 +91,7 @@ func resourceStream() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"stream_name": {
 				Type:     schema.TypeString,
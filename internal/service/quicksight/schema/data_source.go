@@ -431,7 +431,7 @@ func DataSourceParametersSchema() *schema.Schema {
 							names.AttrRoleARN: {
 								Type:         schema.TypeString,
 								Optional:     true,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: verify.ValidIAMRoleARN,
 							},
 						},
 					},
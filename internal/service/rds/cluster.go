@@ -303,7 +303,7 @@ func resourceCluster() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			"manage_master_user_password": {
 				Type:          schema.TypeBool,
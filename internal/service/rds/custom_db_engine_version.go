@@ -112,7 +112,7 @@ func resourceCustomDBEngineVersion() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			"major_engine_version": {
 				Type:     schema.TypeString,
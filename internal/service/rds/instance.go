@@ -373,7 +373,7 @@ func resourceInstance() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			"latest_restorable_time": {
 				Type:     schema.TypeString,
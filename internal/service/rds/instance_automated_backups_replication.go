@@ -55,7 +55,7 @@ func resourceInstanceAutomatedBackupsReplication() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			"pre_signed_url": {
 				Type:     schema.TypeString,
@@ -92,7 +92,7 @@ func resourceNamespace() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			"log_exports": {
 				Type:     schema.TypeSet,
@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_route53_records_exclusive", name="Records Exclusive")
+func newResourceRecordsExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceRecordsExclusive{}, nil
+}
+
+const (
+	ResNameRecordsExclusive = "Records Exclusive"
+)
+
+type resourceRecordsExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *resourceRecordsExclusive) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_route53_records_exclusive"
+}
+
+func (r *resourceRecordsExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"record_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceRecordsExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceRecordsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var recordIDs []string
+	resp.Diagnostics.Append(plan.RecordIDs.ElementsAs(ctx, &recordIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncRecords(ctx, plan.ZoneID.ValueString(), recordIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionCreating, ResNameRecordsExclusive, plan.ZoneID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceRecordsExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().Route53Client(ctx)
+
+	var state resourceRecordsExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := cleanZoneID(state.ZoneID.ValueString())
+	zoneName, records, err := findExclusiveResourceRecordSetsByZoneID(ctx, conn, zoneID)
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionReading, ResNameRecordsExclusive, state.ZoneID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	var stateRecordIDs []string
+	resp.Diagnostics.Append(state.RecordIDs.ElementsAs(ctx, &stateRecordIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Record IDs in the aws_route53_record format may name the same record in several ways,
+	// e.g. relative or fully qualified names. Keep the form already in state.
+	stateRecordIDsByKey := make(map[string]string)
+	for _, id := range stateRecordIDs {
+		if key, err := recordsExclusiveKeyFromID(zoneName, id); err == nil {
+			stateRecordIDsByKey[key] = id
+		}
+	}
+
+	recordIDs := make([]string, 0, len(records))
+	for key := range records {
+		if id, ok := stateRecordIDsByKey[key]; ok {
+			recordIDs = append(recordIDs, id)
+		} else {
+			recordIDs = append(recordIDs, key)
+		}
+	}
+
+	state.RecordIDs = flex.FlattenFrameworkStringValueSetLegacy(ctx, recordIDs)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceRecordsExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceRecordsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RecordIDs.Equal(state.RecordIDs) {
+		var recordIDs []string
+		resp.Diagnostics.Append(plan.RecordIDs.ElementsAs(ctx, &recordIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncRecords(ctx, plan.ZoneID.ValueString(), recordIDs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Route53, create.ErrActionUpdating, ResNameRecordsExclusive, plan.ZoneID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncRecords handles keeping the configured resource record sets
+// in sync with the remote resource.
+//
+// Records in the hosted zone but not configured on this resource
+// will be deleted. Records are created by other resources, so configured
+// records missing from the hosted zone are an error.
+// The zone apex NS and SOA records are never deleted.
+func (r *resourceRecordsExclusive) syncRecords(ctx context.Context, zoneID string, wantIDs []string) error {
+	conn := r.Meta().Route53Client(ctx)
+
+	zoneID = cleanZoneID(zoneID)
+	zoneName, records, err := findExclusiveResourceRecordSetsByZoneID(ctx, conn, zoneID)
+	if err != nil {
+		return err
+	}
+
+	want := make([]string, 0, len(wantIDs))
+	for _, id := range wantIDs {
+		key, err := recordsExclusiveKeyFromID(zoneName, id)
+		if err != nil {
+			return err
+		}
+		if v := recordParseResourceID(id)[0]; v != zoneID {
+			return fmt.Errorf("record (%s) is not in hosted zone (%s)", id, zoneID)
+		}
+		want = append(want, key)
+	}
+
+	members := framework.ExclusiveMembers[string]{
+		Have: slices.Collect(maps.Keys(records)),
+		Want: want,
+		Remove: func(ctx context.Context, key string) error {
+			input := &route53.ChangeResourceRecordSetsInput{
+				ChangeBatch: &awstypes.ChangeBatch{
+					Changes: []awstypes.Change{
+						{
+							Action:            awstypes.ChangeActionDelete,
+							ResourceRecordSet: records[key],
+						},
+					},
+					Comment: aws.String("Deleted by Terraform"),
+				},
+				HostedZoneId: aws.String(zoneID),
+			}
+
+			output, err := conn.ChangeResourceRecordSets(ctx, input)
+			if err != nil {
+				return fmt.Errorf("deleting Route53 Record (%s): %w", key, err)
+			}
+
+			if output.ChangeInfo != nil {
+				if _, err := waitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id)); err != nil {
+					return fmt.Errorf("waiting for Route 53 Record (%s) synchronize: %w", key, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	return members.Sync(ctx)
+}
+
+func (r *resourceRecordsExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("zone_id"), req, resp)
+}
+
+// findExclusiveResourceRecordSetsByZoneID returns the name of a hosted zone and its resource record sets,
+// keyed by normalized record ID. The zone apex NS and SOA records are not returned.
+func findExclusiveResourceRecordSetsByZoneID(ctx context.Context, conn *route53.Client, zoneID string) (string, map[string]*awstypes.ResourceRecordSet, error) {
+	zone, err := findHostedZoneByID(ctx, conn, zoneID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	zoneName := aws.ToString(zone.HostedZone.Name)
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	output, err := findResourceRecordSets(ctx, conn, input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), func(v *awstypes.ResourceRecordSet) bool {
+		// Zone NS & SOA records cannot be deleted.
+		if normalizeDomainName(v.Name) == normalizeDomainName(zoneName) && (v.Type == awstypes.RRTypeNs || v.Type == awstypes.RRTypeSoa) {
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	records := make(map[string]*awstypes.ResourceRecordSet, len(output))
+	for _, v := range output {
+		key := recordsExclusiveKey(zoneID, normalizeDomainName(v.Name), string(v.Type), aws.ToString(v.SetIdentifier))
+		records[key] = &v
+	}
+
+	return zoneName, records, nil
+}
+
+// recordsExclusiveKeyFromID returns the normalized form of an aws_route53_record resource ID.
+func recordsExclusiveKeyFromID(zoneName, id string) (string, error) {
+	parts := recordParseResourceID(id)
+	if parts[0] == "" || parts[2] == "" {
+		return "", fmt.Errorf("unexpected format for record ID (%s), expected ZONEID_NAME_TYPE or ZONEID_NAME_TYPE_SET-IDENTIFIER", id)
+	}
+
+	return recordsExclusiveKey(parts[0], expandRecordName(parts[1], zoneName), parts[2], parts[3]), nil
+}
+
+func recordsExclusiveKey(zoneID, name, recordType, setIdentifier string) string {
+	parts := []string{zoneID, name, strings.ToUpper(recordType)}
+	if setIdentifier != "" {
+		parts = append(parts, setIdentifier)
+	}
+
+	return strings.Join(parts, "_")
+}
+
+type resourceRecordsExclusiveData struct {
+	RecordIDs types.Set    `tfsdk:"record_ids"`
+	ZoneID    types.String `tfsdk:"zone_id"`
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53RecordsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneResourceName := "aws_route53_zone.test"
+	recordResourceName := "aws_route53_record.test"
+	zoneName := acctest.RandomDomain()
+	recordName := zoneName.RandomSubdomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName.String(), recordName.String()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", zoneResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "record_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "record_ids.*", recordResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "zone_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone_id",
+			},
+		},
+	})
+}
+
+// A record added out of band should be deleted
+func TestAccRoute53RecordsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var zone route53.GetHostedZoneOutput
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneResourceName := "aws_route53_zone.test"
+	zoneName := acctest.RandomDomain()
+	recordName := zoneName.RandomSubdomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName.String(), recordName.String()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneExists(ctx, zoneResourceName, &zone),
+					testAccCheckRecordsExclusiveExists(ctx, resourceName),
+					testAccCheckRecordsExclusiveCreateRecord(ctx, &zone, "oob."+zoneName.String()),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName.String(), recordName.String()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecordsExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return create.Error(names.Route53, create.ErrActionCheckingExistence, tfroute53.ResNameRecordsExclusive, n, errors.New("not found"))
+		}
+
+		zoneID := rs.Primary.Attributes["zone_id"]
+		if zoneID == "" {
+			return create.Error(names.Route53, create.ErrActionCheckingExistence, tfroute53.ResNameRecordsExclusive, n, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+		_, out, err := tfroute53.FindExclusiveResourceRecordSetsByZoneID(ctx, conn, zoneID)
+		if err != nil {
+			return create.Error(names.Route53, create.ErrActionCheckingExistence, tfroute53.ResNameRecordsExclusive, zoneID, err)
+		}
+
+		if rs.Primary.Attributes["record_ids.#"] != strconv.Itoa(len(out)) {
+			return create.Error(names.Route53, create.ErrActionCheckingExistence, tfroute53.ResNameRecordsExclusive, zoneID, errors.New("unexpected record_ids count"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordsExclusiveCreateRecord(ctx context.Context, zone *route53.GetHostedZoneOutput, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		_, err := conn.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &awstypes.ChangeBatch{
+				Changes: []awstypes.Change{{
+					Action: awstypes.ChangeActionCreate,
+					ResourceRecordSet: &awstypes.ResourceRecordSet{
+						Name: aws.String(name),
+						ResourceRecords: []awstypes.ResourceRecord{{
+							Value: aws.String(`"out-of-band"`),
+						}},
+						TTL:  aws.Int64(30),
+						Type: awstypes.RRTypeTxt,
+					},
+				}},
+			},
+			HostedZoneId: zone.HostedZone.Id,
+		})
+
+		return err
+	}
+}
+
+func testAccRecordsExclusiveConfig_basic(zoneName, recordName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = %[2]q
+  type    = "A"
+  ttl     = "30"
+  records = ["127.0.0.1"]
+}
+
+resource "aws_route53_records_exclusive" "test" {
+  zone_id    = aws_route53_zone.test.zone_id
+  record_ids = [aws_route53_record.test.id]
+}
+`, zoneName, recordName)
+}
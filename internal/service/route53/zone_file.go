@@ -0,0 +1,475 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+)
+
+// zoneFileToken is a whitespace-separated field of a zone file entry.
+// The text of a quoted string does not include the surrounding quotes.
+type zoneFileToken struct {
+	quoted bool
+	text   string
+}
+
+// zoneFileEntry is a logical line of a zone file, i.e. a directive or a resource record.
+type zoneFileEntry struct {
+	blankOwner bool // The entry starts with whitespace and so has no owner name.
+	line       int
+	tokens     []zoneFileToken
+}
+
+// tokenizeZoneFile splits RFC 1035 master file content into entries.
+// Comments are removed and entries that span multiple lines using parentheses are joined.
+func tokenizeZoneFile(content string) ([]zoneFileEntry, error) {
+	var (
+		entries []zoneFileEntry
+		entry   zoneFileEntry
+		token   strings.Builder
+		inToken bool
+		depth   int
+	)
+
+	line, startOfLine := 1, true
+	flush := func() {
+		if inToken {
+			entry.tokens = append(entry.tokens, zoneFileToken{text: token.String()})
+			token.Reset()
+			inToken = false
+		}
+	}
+
+	for i := 0; i < len(content); i++ {
+		ch := content[i]
+
+		if startOfLine && depth == 0 {
+			entry = zoneFileEntry{blankOwner: ch == ' ' || ch == '\t', line: line}
+		}
+		startOfLine = false
+
+		switch ch {
+		case '\n':
+			flush()
+			line++
+			if depth == 0 {
+				if len(entry.tokens) > 0 {
+					entries = append(entries, entry)
+				}
+				startOfLine = true
+			}
+		case ' ', '\t', '\r':
+			flush()
+		case ';':
+			flush()
+			for i+1 < len(content) && content[i+1] != '\n' {
+				i++
+			}
+		case '(':
+			flush()
+			depth++
+		case ')':
+			flush()
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+			}
+			depth--
+		case '"':
+			flush()
+			var quoted strings.Builder
+			for i++; ; i++ {
+				if i >= len(content) || content[i] == '\n' {
+					return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+				}
+				if content[i] == '"' {
+					break
+				}
+				if content[i] == '\\' && i+1 < len(content) && content[i+1] != '\n' {
+					quoted.WriteByte(content[i])
+					i++
+				}
+				quoted.WriteByte(content[i])
+			}
+			entry.tokens = append(entry.tokens, zoneFileToken{quoted: true, text: quoted.String()})
+		case '\\':
+			inToken = true
+			token.WriteByte(ch)
+			if i+1 < len(content) && content[i+1] != '\n' {
+				i++
+				token.WriteByte(content[i])
+			}
+		default:
+			inToken = true
+			token.WriteByte(ch)
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", entry.line)
+	}
+
+	flush()
+	if len(entry.tokens) > 0 {
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// parseZoneFile parses RFC 1035 master file content into resource record sets.
+// Record names and domain names in record data are returned fully qualified.
+// Resource records with the same name and type are grouped into a single record set, which has the TTL of its first record.
+func parseZoneFile(content, origin string, defaultTTL *int64) ([]awstypes.ResourceRecordSet, error) {
+	entries, err := tokenizeZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if origin != "" {
+		origin = strings.ToLower(fqdn(origin))
+	}
+
+	var (
+		owner           string
+		ttl, lastTTL    *int64
+		recordSets      []awstypes.ResourceRecordSet
+		recordSetsIndex = make(map[string]int)
+	)
+
+	for _, entry := range entries {
+		tokens := entry.tokens
+
+		if first := tokens[0]; !entry.blankOwner && !first.quoted && strings.HasPrefix(first.text, "$") {
+			switch directive := strings.ToUpper(first.text); directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: %s requires a domain name", entry.line, directive)
+				}
+				if origin, err = zoneFileAbsoluteName(tokens[1].text, origin); err != nil {
+					return nil, fmt.Errorf("line %d: %w", entry.line, err)
+				}
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: %s requires a TTL", entry.line, directive)
+				}
+				v, ok := parseZoneFileTTL(tokens[1].text)
+				if !ok {
+					return nil, fmt.Errorf("line %d: invalid TTL %q", entry.line, tokens[1].text)
+				}
+				ttl = aws.Int64(v)
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive %s", entry.line, directive)
+			}
+
+			continue
+		}
+
+		if !entry.blankOwner {
+			if owner, err = zoneFileAbsoluteName(tokens[0].text, origin); err != nil {
+				return nil, fmt.Errorf("line %d: %w", entry.line, err)
+			}
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: no owner name", entry.line)
+		}
+
+		// The TTL and class are optional and can appear in either order.
+		var recordTTL *int64
+		for range 2 {
+			if len(tokens) == 0 || tokens[0].quoted {
+				break
+			}
+
+			if v := strings.ToUpper(tokens[0].text); slices.Contains([]string{"IN", "CH", "CS", "HS"}, v) {
+				if v != "IN" {
+					return nil, fmt.Errorf("line %d: unsupported class %s", entry.line, v)
+				}
+			} else if v, ok := parseZoneFileTTL(v); ok {
+				recordTTL = aws.Int64(v)
+				lastTTL = recordTTL
+			} else {
+				break
+			}
+
+			tokens = tokens[1:]
+		}
+
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: missing record type or data", entry.line)
+		}
+
+		rrType := awstypes.RRType(strings.ToUpper(tokens[0].text))
+		if !slices.Contains(enum.EnumValues[awstypes.RRType](), rrType) {
+			return nil, fmt.Errorf("line %d: unsupported record type %s", entry.line, tokens[0].text)
+		}
+
+		value, err := formatZoneFileRecordData(rrType, tokens[1:], origin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s record: %w", entry.line, rrType, err)
+		}
+
+		switch {
+		case recordTTL != nil:
+		case ttl != nil:
+			recordTTL = ttl
+		case lastTTL != nil:
+			recordTTL = lastTTL
+		case defaultTTL != nil:
+			recordTTL = defaultTTL
+		default:
+			return nil, fmt.Errorf("line %d: no TTL specified and no $TTL or default TTL", entry.line)
+		}
+
+		key := owner + " " + string(rrType)
+		i, ok := recordSetsIndex[key]
+		if !ok {
+			i = len(recordSets)
+			recordSetsIndex[key] = i
+			recordSets = append(recordSets, awstypes.ResourceRecordSet{
+				Name: aws.String(owner),
+				TTL:  aws.Int64(*recordTTL),
+				Type: rrType,
+			})
+		}
+
+		if !slices.ContainsFunc(recordSets[i].ResourceRecords, func(v awstypes.ResourceRecord) bool {
+			return aws.ToString(v.Value) == value
+		}) {
+			recordSets[i].ResourceRecords = append(recordSets[i].ResourceRecords, awstypes.ResourceRecord{Value: aws.String(value)})
+		}
+	}
+
+	return recordSets, nil
+}
+
+// zoneFileAbsoluteName returns the fully qualified, lowercase form of a domain name relative to the origin.
+func zoneFileAbsoluteName(name, origin string) (string, error) {
+	switch {
+	case name == "@":
+		if origin == "" {
+			return "", errors.New("@ requires an origin")
+		}
+		return origin, nil
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name), nil
+	case origin == "":
+		return "", fmt.Errorf("relative name %q requires an origin", name)
+	case origin == ".":
+		return strings.ToLower(name) + ".", nil
+	default:
+		return strings.ToLower(name) + "." + origin, nil
+	}
+}
+
+// parseZoneFileTTL parses a TTL in seconds, optionally using BIND's unit suffixes, e.g. "1h30m".
+func parseZoneFileTTL(s string) (int64, bool) {
+	if v, err := strconv.ParseInt(s, 10, 32); err == nil {
+		return v, v >= 0
+	}
+
+	var ttl, n int64
+	var digits bool
+	for _, ch := range strings.ToLower(s) {
+		if ch >= '0' && ch <= '9' {
+			n = n*10 + int64(ch-'0')
+			digits = true
+			continue
+		}
+
+		if !digits {
+			return 0, false
+		}
+
+		switch ch {
+		case 's':
+		case 'm':
+			n *= 60
+		case 'h':
+			n *= 60 * 60
+		case 'd':
+			n *= 24 * 60 * 60
+		case 'w':
+			n *= 7 * 24 * 60 * 60
+		default:
+			return 0, false
+		}
+
+		ttl += n
+		n, digits = 0, false
+	}
+
+	if digits || ttl == 0 || ttl > 1<<31-1 {
+		return 0, false
+	}
+
+	return ttl, true
+}
+
+// formatZoneFileRecordData returns the Route 53 value of a resource record's data.
+func formatZoneFileRecordData(rrType awstypes.RRType, tokens []zoneFileToken, origin string) (string, error) {
+	// Minimum number of fields and indexes of fields that are domain names.
+	var (
+		minFields   = 1
+		nameIndexes []int
+	)
+	switch rrType {
+	case awstypes.RRTypeA, awstypes.RRTypeAaaa:
+		if len(tokens) != 1 {
+			return "", errors.New("expected an IP address")
+		}
+		ip := net.ParseIP(tokens[0].text)
+		if ip == nil || (ip.To4() != nil) != (rrType == awstypes.RRTypeA) {
+			return "", fmt.Errorf("invalid IP address %q", tokens[0].text)
+		}
+		return tokens[0].text, nil
+	case awstypes.RRTypeTxt, awstypes.RRTypeSpf:
+		// Each character string is quoted, e.g. "v=spf1 include:example.com" "~all".
+		return strings.Join(formatZoneFileStrings(tokens, true), " "), nil
+	case awstypes.RRTypeCaa:
+		if len(tokens) != 3 {
+			return "", errors.New("expected flags, tag and value")
+		}
+		tokens[2].quoted = true
+	case awstypes.RRTypeCname, awstypes.RRTypeNs, awstypes.RRTypePtr:
+		nameIndexes = []int{0}
+	case awstypes.RRTypeMx:
+		minFields, nameIndexes = 2, []int{1}
+	case awstypes.RRTypeSrv:
+		minFields, nameIndexes = 4, []int{3}
+	case awstypes.RRTypeSoa:
+		minFields, nameIndexes = 7, []int{0, 1}
+	case awstypes.RRTypeNaptr:
+		minFields, nameIndexes = 6, []int{5}
+		for i := 2; i <= 4 && i < len(tokens); i++ {
+			tokens[i].quoted = true
+		}
+	}
+
+	if len(tokens) < minFields {
+		return "", fmt.Errorf("expected at least %d fields, got %d", minFields, len(tokens))
+	}
+
+	for _, i := range nameIndexes {
+		name, err := zoneFileAbsoluteName(tokens[i].text, origin)
+		if err != nil {
+			return "", err
+		}
+		tokens[i].text = name
+	}
+
+	return strings.Join(formatZoneFileStrings(tokens, false), " "), nil
+}
+
+func formatZoneFileStrings(tokens []zoneFileToken, quoteAll bool) []string {
+	s := make([]string, 0, len(tokens))
+
+	for _, token := range tokens {
+		if token.quoted || quoteAll {
+			s = append(s, `"`+token.text+`"`)
+		} else {
+			s = append(s, token.text)
+		}
+	}
+
+	return s
+}
+
+// formatZoneFile returns the resource record sets as RFC 1035 master file content.
+// Alias and traffic policy records cannot be represented and are written as comments.
+func formatZoneFile(origin string, recordSets []awstypes.ResourceRecordSet) string {
+	origin = strings.ToLower(fqdn(origin))
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "$ORIGIN %s\n", origin)
+
+	for _, recordSet := range recordSets {
+		name := zoneFileRelativeName(zoneFileDecodeName(aws.ToString(recordSet.Name)), origin)
+
+		if v := aws.ToString(recordSet.SetIdentifier); v != "" {
+			fmt.Fprintf(&sb, "; %s routing policy, set identifier %q\n", zoneFileRoutingPolicy(recordSet), v)
+		}
+
+		switch {
+		case recordSet.AliasTarget != nil:
+			fmt.Fprintf(&sb, "; %s\tIN\t%s\tALIAS\t%s (hosted zone %s)\n", name, recordSet.Type, aws.ToString(recordSet.AliasTarget.DNSName), aws.ToString(recordSet.AliasTarget.HostedZoneId))
+		case aws.ToString(recordSet.TrafficPolicyInstanceId) != "":
+			fmt.Fprintf(&sb, "; %s\tIN\t%s\tTRAFFIC POLICY INSTANCE\t%s\n", name, recordSet.Type, aws.ToString(recordSet.TrafficPolicyInstanceId))
+		default:
+			for _, rr := range recordSet.ResourceRecords {
+				fmt.Fprintf(&sb, "%s\t%d\tIN\t%s\t%s\n", name, aws.ToInt64(recordSet.TTL), recordSet.Type, aws.ToString(rr.Value))
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// zoneFileRelativeName returns a fully qualified domain name relative to the origin, if it is within the origin.
+func zoneFileRelativeName(name, origin string) string {
+	name = strings.ToLower(fqdn(name))
+
+	switch {
+	case name == origin:
+		return "@"
+	case origin != "." && strings.HasSuffix(name, "."+origin):
+		return strings.TrimSuffix(name, "."+origin)
+	default:
+		return name
+	}
+}
+
+// zoneFileDecodeName converts the three-digit octal escape codes used by Route 53 to the characters they represent,
+// or to the three-digit decimal escape codes used in master files if the character must remain escaped.
+// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DomainNameFormat.html#domain-name-format-hosted-zones.
+func zoneFileDecodeName(name string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(name); i++ {
+		if name[i] == '\\' && i+3 < len(name) {
+			if v, err := strconv.ParseUint(name[i+1:i+4], 8, 8); err == nil {
+				switch ch := byte(v); {
+				case ch == '*' || ch == '-' || ch == '_' || ch >= '0' && ch <= '9' || ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z':
+					sb.WriteByte(ch)
+				default:
+					fmt.Fprintf(&sb, "\\%03d", ch)
+				}
+				i += 3
+				continue
+			}
+		}
+		sb.WriteByte(name[i])
+	}
+
+	return sb.String()
+}
+
+func zoneFileRoutingPolicy(recordSet awstypes.ResourceRecordSet) string {
+	switch {
+	case recordSet.Weight != nil:
+		return "Weighted"
+	case recordSet.Region != "":
+		return "Latency"
+	case recordSet.Failover != "":
+		return "Failover"
+	case recordSet.GeoLocation != nil:
+		return "Geolocation"
+	case recordSet.GeoProximityLocation != nil:
+		return "Geoproximity"
+	case recordSet.CidrRoutingConfig != nil:
+		return "IP-based"
+	case aws.ToBool(recordSet.MultiValueAnswer):
+		return "Multivalue answer"
+	default:
+		return "Unknown"
+	}
+}
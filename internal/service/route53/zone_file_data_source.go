@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_route53_zone_file", name="Zone File")
+func newZoneFileDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &zoneFileDataSource{}, nil
+}
+
+type zoneFileDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*zoneFileDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_route53_zone_file"
+}
+
+func (d *zoneFileDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrContent: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			"zone_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *zoneFileDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data zoneFileDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().Route53Client(ctx)
+
+	hostedZoneID := fwflex.StringValueFromFramework(ctx, data.ZoneID)
+	hostedZone, err := findHostedZoneByID(ctx, conn, hostedZoneID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Hosted Zone (%s)", hostedZoneID), err.Error())
+
+		return
+	}
+
+	input := route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(hostedZoneID),
+	}
+	output, err := findResourceRecordSets(ctx, conn, &input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), tfslices.PredicateTrue[*awstypes.ResourceRecordSet]())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing Route 53 Records (%s)", hostedZoneID), err.Error())
+
+		return
+	}
+
+	name := normalizeDomainName(hostedZone.HostedZone.Name)
+	data.Content = types.StringValue(formatZoneFile(name, output))
+	data.Name = types.StringValue(name)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type zoneFileDataSourceModel struct {
+	Content types.String `tfsdk:"content"`
+	Name    types.String `tfsdk:"name"`
+	ZoneID  types.String `tfsdk:"zone_id"`
+}
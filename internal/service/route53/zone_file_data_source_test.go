@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ZoneFileDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_zone_file.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileDataSourceConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, zoneName.String()),
+					resource.TestCheckResourceAttrWith(dataSourceName, names.AttrContent, func(v string) error {
+						if expected := fmt.Sprintf("$ORIGIN %s.\n", zoneName.String()); !strings.HasPrefix(v, expected) {
+							return fmt.Errorf("expected content to start with %q, got %q", expected, v)
+						}
+						return nil
+					}),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrContent, regexache.MustCompile(`\n@\t172800\tIN\tNS\t`)),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrContent, regexache.MustCompile(`\nwww\t30\tIN\tA\t127\.0\.0\.1\n`)),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrContent, regexache.MustCompile(`\ntxt\t30\tIN\tTXT\t"v=spf1 -all" "x"\n`)),
+					// The exported zone file can be parsed back into records.
+					resource.TestCheckResourceAttr("data.aws_route53_zone_file_records.test", "record_sets.#", "4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccZoneFileDataSourceConfig_basic(zName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = "%[1]s."
+}
+
+resource "aws_route53_record" "www" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www"
+  type    = "A"
+  ttl     = "30"
+  records = ["127.0.0.1"]
+}
+
+resource "aws_route53_record" "txt" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "txt"
+  type    = "TXT"
+  ttl     = "30"
+  records = ["v=spf1 -all\" \"x"]
+}
+
+data "aws_route53_zone_file" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  depends_on = [aws_route53_record.www, aws_route53_record.txt]
+}
+
+data "aws_route53_zone_file_records" "test" {
+  content = data.aws_route53_zone_file.test.content
+}
+`, zName)
+}
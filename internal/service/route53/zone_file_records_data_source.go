@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_route53_zone_file_records", name="Zone File Records")
+func newZoneFileRecordsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &zoneFileRecordsDataSource{}, nil
+}
+
+type zoneFileRecordsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*zoneFileRecordsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_route53_zone_file_records"
+}
+
+func (d *zoneFileRecordsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrContent: schema.StringAttribute{
+				Required: true,
+			},
+			"default_ttl": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 2147483647),
+				},
+			},
+			"origin": schema.StringAttribute{
+				Optional: true,
+			},
+			"record_sets": framework.DataSourceComputedListOfObjectAttribute[zoneFileRecordSetModel](ctx),
+		},
+	}
+}
+
+func (d *zoneFileRecordsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data zoneFileRecordsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	recordSets, err := parseZoneFile(data.Content.ValueString(), data.Origin.ValueString(), fwflex.Int64FromFramework(ctx, data.DefaultTTL))
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing zone file", err.Error())
+
+		return
+	}
+
+	models := make([]zoneFileRecordSetModel, 0, len(recordSets))
+	for _, recordSet := range recordSets {
+		models = append(models, zoneFileRecordSetModel{
+			Name:    types.StringValue(strings.TrimSuffix(aws.ToString(recordSet.Name), ".")),
+			Records: fwflex.FlattenFrameworkStringValueListOfString(ctx, flattenResourceRecords(recordSet.ResourceRecords, recordSet.Type)),
+			TTL:     types.Int64PointerValue(recordSet.TTL),
+			Type:    types.StringValue(string(recordSet.Type)),
+		})
+	}
+	data.RecordSets = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, models)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type zoneFileRecordsDataSourceModel struct {
+	Content    types.String                                            `tfsdk:"content"`
+	DefaultTTL types.Int64                                             `tfsdk:"default_ttl"`
+	Origin     types.String                                            `tfsdk:"origin"`
+	RecordSets fwtypes.ListNestedObjectValueOf[zoneFileRecordSetModel] `tfsdk:"record_sets"`
+}
+
+type zoneFileRecordSetModel struct {
+	Name    types.String                      `tfsdk:"name"`
+	Records fwtypes.ListValueOf[types.String] `tfsdk:"records"`
+	TTL     types.Int64                       `tfsdk:"ttl"`
+	Type    types.String                      `tfsdk:"type"`
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ZoneFileRecordsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_zone_file_records.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileRecordsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "record_sets.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_sets.0.name", "example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_sets.0.type", "A"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_sets.0.ttl", "3600"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_sets.0.records.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_sets.0.records.0", "192.0.2.1"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_sets.1.name", "www.example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_sets.1.type", "CNAME"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_sets.1.ttl", "300"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_sets.1.records.0", "example.com."),
+					resource.TestCheckResourceAttr(dataSourceName, "record_sets.2.type", "TXT"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_sets.2.records.0", `v=spf1 -all" "x`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneFileRecordsDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccZoneFileRecordsDataSourceConfig_invalid,
+				ExpectError: regexache.MustCompile(`line 1: relative name "www" requires an origin`),
+			},
+		},
+	})
+}
+
+const testAccZoneFileRecordsDataSourceConfig_basic = `
+data "aws_route53_zone_file_records" "test" {
+  origin = "example.com"
+
+  content = <<EOT
+$TTL 1h
+@    IN  A      192.0.2.1
+@    IN  A      192.0.2.2
+www  300 IN CNAME  @
+@    IN  TXT    "v=spf1 -all" "x"
+EOT
+}
+`
+
+const testAccZoneFileRecordsDataSourceConfig_invalid = `
+data "aws_route53_zone_file_records" "test" {
+  content = "www 300 IN A 192.0.2.1"
+}
+`
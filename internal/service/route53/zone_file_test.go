@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/google/go-cmp/cmp"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content     string
+		origin      string
+		defaultTTL  *int64
+		expected    []awstypes.ResourceRecordSet
+		expectedErr string
+	}{
+		"directives": {
+			content: `
+$ORIGIN example.com.
+$TTL 1h
+@        IN  A      192.0.2.1
+www      300 IN CNAME  @
+         IN  TXT    "www"
+mail.Example.com.  IN 60 MX 10 mx1
+                   IN 60 MX 20 mx2.example.net.
+`,
+			expected: []awstypes.ResourceRecordSet{
+				{Name: aws.String("example.com."), Type: awstypes.RRTypeA, TTL: aws.Int64(3600), ResourceRecords: resourceRecords("192.0.2.1")},
+				{Name: aws.String("www.example.com."), Type: awstypes.RRTypeCname, TTL: aws.Int64(300), ResourceRecords: resourceRecords("example.com.")},
+				{Name: aws.String("www.example.com."), Type: awstypes.RRTypeTxt, TTL: aws.Int64(3600), ResourceRecords: resourceRecords(`"www"`)},
+				{Name: aws.String("mail.example.com."), Type: awstypes.RRTypeMx, TTL: aws.Int64(60), ResourceRecords: resourceRecords("10 mx1.example.com.", "20 mx2.example.net.")},
+			},
+		},
+		"origin argument": {
+			content: `
+api  A  192.0.2.10
+api  A  192.0.2.11
+api  A  192.0.2.10
+`,
+			origin:     "Example.com",
+			defaultTTL: aws.Int64(30),
+			expected: []awstypes.ResourceRecordSet{
+				{Name: aws.String("api.example.com."), Type: awstypes.RRTypeA, TTL: aws.Int64(30), ResourceRecords: resourceRecords("192.0.2.10", "192.0.2.11")},
+			},
+		},
+		"last explicit TTL": {
+			content: `
+a.example.com.  120  AAAA  2001:db8::1
+b.example.com.       AAAA  2001:db8::2
+`,
+			expected: []awstypes.ResourceRecordSet{
+				{Name: aws.String("a.example.com."), Type: awstypes.RRTypeAaaa, TTL: aws.Int64(120), ResourceRecords: resourceRecords("2001:db8::1")},
+				{Name: aws.String("b.example.com."), Type: awstypes.RRTypeAaaa, TTL: aws.Int64(120), ResourceRecords: resourceRecords("2001:db8::2")},
+			},
+		},
+		"multi-string TXT and parentheses": {
+			content: `
+$ORIGIN example.com.
+$TTL 300
+@  IN  SOA  ns1 hostmaster (
+              2024010101 ; serial
+              7200       ; refresh
+              900        ; retry
+              1209600    ; expire
+              86400 )    ; minimum
+@  IN  TXT  "v=spf1 include:_spf.example.net" "~all"
+@  IN  TXT  ( "first;part"
+              "second part" )
+_sip._tcp  IN  SRV  10 60 5060 sip
+@  IN  CAA  0 issue "letsencrypt.org"
+`,
+			expected: []awstypes.ResourceRecordSet{
+				{Name: aws.String("example.com."), Type: awstypes.RRTypeSoa, TTL: aws.Int64(300), ResourceRecords: resourceRecords("ns1.example.com. hostmaster.example.com. 2024010101 7200 900 1209600 86400")},
+				{Name: aws.String("example.com."), Type: awstypes.RRTypeTxt, TTL: aws.Int64(300), ResourceRecords: resourceRecords(`"v=spf1 include:_spf.example.net" "~all"`, `"first;part" "second part"`)},
+				{Name: aws.String("_sip._tcp.example.com."), Type: awstypes.RRTypeSrv, TTL: aws.Int64(300), ResourceRecords: resourceRecords("10 60 5060 sip.example.com.")},
+				{Name: aws.String("example.com."), Type: awstypes.RRTypeCaa, TTL: aws.Int64(300), ResourceRecords: resourceRecords(`0 issue "letsencrypt.org"`)},
+			},
+		},
+		"TTL units": {
+			content:  "example.com. 1h30m IN A 192.0.2.1\n",
+			expected: []awstypes.ResourceRecordSet{{Name: aws.String("example.com."), Type: awstypes.RRTypeA, TTL: aws.Int64(5400), ResourceRecords: resourceRecords("192.0.2.1")}},
+		},
+		"relative name without origin": {
+			content:     "www 300 IN A 192.0.2.1\n",
+			expectedErr: `line 1: relative name "www" requires an origin`,
+		},
+		"no TTL": {
+			content:     "example.com. IN A 192.0.2.1\n",
+			expectedErr: "line 1: no TTL specified",
+		},
+		"unsupported class": {
+			content:     "example.com. 300 CH A 192.0.2.1\n",
+			expectedErr: "line 1: unsupported class CH",
+		},
+		"unsupported directive": {
+			content:     "$INCLUDE other.zone\n",
+			expectedErr: "line 1: unsupported directive $INCLUDE",
+		},
+		"unsupported type": {
+			content:     "example.com. 300 IN HINFO PC Linux\n",
+			expectedErr: "line 1: unsupported record type HINFO",
+		},
+		"invalid address": {
+			content:     "\nexample.com. 300 IN A 2001:db8::1\n",
+			expectedErr: `line 2: A record: invalid IP address "2001:db8::1"`,
+		},
+		"unbalanced parentheses": {
+			content:     "example.com. 300 IN TXT ( \"a\"\n",
+			expectedErr: "unbalanced parentheses",
+		},
+		"unterminated string": {
+			content:     "example.com. 300 IN TXT \"a\n",
+			expectedErr: "line 1: unterminated quoted string",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfroute53.ParseZoneFile(testCase.content, testCase.origin, testCase.defaultTTL)
+
+			if testCase.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectedErr) {
+					t.Fatalf("expected error containing %q, got %v", testCase.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(awstypes.ResourceRecordSet{}, awstypes.ResourceRecord{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFormatZoneFile(t *testing.T) {
+	t.Parallel()
+
+	recordSets := []awstypes.ResourceRecordSet{
+		{Name: aws.String("example.com."), Type: awstypes.RRTypeNs, TTL: aws.Int64(172800), ResourceRecords: resourceRecords("ns-1.awsdns-01.org.", "ns-2.awsdns-02.net.")},
+		{Name: aws.String("\\052.example.com."), Type: awstypes.RRTypeTxt, TTL: aws.Int64(300), ResourceRecords: resourceRecords(`"v=spf1 -all" "x"`)},
+		{Name: aws.String("a\\100b.example.com."), Type: awstypes.RRTypeA, TTL: aws.Int64(60), ResourceRecords: resourceRecords("192.0.2.1")},
+		{Name: aws.String("other.example.net."), Type: awstypes.RRTypeCname, TTL: aws.Int64(60), ResourceRecords: resourceRecords("example.com.")},
+		{Name: aws.String("www.example.com."), Type: awstypes.RRTypeA, SetIdentifier: aws.String("blue"), Weight: aws.Int64(10), TTL: aws.Int64(60), ResourceRecords: resourceRecords("192.0.2.2")},
+		{Name: aws.String("alias.example.com."), Type: awstypes.RRTypeA, AliasTarget: &awstypes.AliasTarget{DNSName: aws.String("lb.example.net."), HostedZoneId: aws.String("Z123")}},
+	}
+
+	expected := `$ORIGIN example.com.
+@	172800	IN	NS	ns-1.awsdns-01.org.
+@	172800	IN	NS	ns-2.awsdns-02.net.
+*	300	IN	TXT	"v=spf1 -all" "x"
+a\064b	60	IN	A	192.0.2.1
+other.example.net.	60	IN	CNAME	example.com.
+; Weighted routing policy, set identifier "blue"
+www	60	IN	A	192.0.2.2
+; alias	IN	A	ALIAS	lb.example.net. (hosted zone Z123)
+`
+
+	got := tfroute53.FormatZoneFile("Example.com", recordSets)
+
+	if got != expected {
+		t.Errorf("got:\n%s\nexpected:\n%s", got, expected)
+	}
+
+	// The exported records, other than the alias, can be parsed back.
+	parsed, err := tfroute53.ParseZoneFile(got, "", nil)
+	if err != nil {
+		t.Fatalf("parsing exported zone file: %s", err)
+	}
+
+	if got, expected := len(parsed), 5; got != expected {
+		t.Errorf("got %d record sets, expected %d", got, expected)
+	}
+}
+
+func resourceRecords(values ...string) []awstypes.ResourceRecord {
+	rrs := make([]awstypes.ResourceRecord, 0, len(values))
+	for _, v := range values {
+		rrs = append(rrs, awstypes.ResourceRecord{Value: aws.String(v)})
+	}
+	return rrs
+}
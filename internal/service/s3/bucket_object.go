@@ -137,7 +137,7 @@ func resourceBucketObject() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			"metadata": {
 				Type:         schema.TypeMap,
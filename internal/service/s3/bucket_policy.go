@@ -8,6 +8,7 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,11 +18,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// bucketPolicyValidation validates bucket policies with IAM Access Analyzer.
+var bucketPolicyValidation = &tfaccessanalyzer.PolicyValidation{
+	Attribute:    names.AttrPolicy,
+	PolicyType:   accessanalyzertypes.PolicyTypeResourcePolicy,
+	ResourceType: accessanalyzertypes.ValidatePolicyResourceTypeS3Bucket,
+}
+
 // @SDKResource("aws_s3_bucket_policy", name="Bucket Policy")
 func resourceBucketPolicy() *schema.Resource {
 	return &schema.Resource{
@@ -52,6 +61,8 @@ func resourceBucketPolicy() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: bucketPolicyValidation.CustomizeDiff,
 	}
 }
 
@@ -93,6 +104,8 @@ func resourceBucketPolicyPut(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
+	diags = append(diags, bucketPolicyValidation.Warnings(ctx, d, meta)...)
+
 	return append(diags, resourceBucketPolicyRead(ctx, d, meta)...)
 }
 
@@ -0,0 +1,715 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	directoryObjectsDefaultMaxConcurrency = 10
+	directoryObjectsDeleteBatchSize       = 1000
+)
+
+// @SDKResource("aws_s3_directory_objects", name="Directory Objects")
+func resourceDirectoryObjects() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectoryObjectsCreate,
+		ReadWithoutTimeout:   resourceDirectoryObjectsRead,
+		UpdateWithoutTimeout: resourceDirectoryObjectsUpdate,
+		DeleteWithoutTimeout: resourceDirectoryObjectsDelete,
+
+		CustomizeDiff: customdiff.Sequence(
+			validateDirectoryObjectsKeyPrefix,
+			resourceDirectoryObjectsCustomizeDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ObjectCannedACL](),
+			},
+			names.AttrBucket: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"delete_orphans": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"etags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"excludes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidGlob,
+				},
+			},
+			"includes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidGlob,
+				},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrKMSKeyID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidKMSKeyARN,
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      directoryObjectsDefaultMaxConcurrency,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			names.AttrRule: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_disposition": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrContentType: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metadata": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ValidateFunc: validateMetadataIsLowerCase,
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidGlob,
+						},
+					},
+				},
+			},
+			"server_side_encryption": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ServerSideEncryption](),
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"source_etags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrStorageClass: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ObjectStorageClass](),
+			},
+		},
+	}
+}
+
+func resourceDirectoryObjectsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket, keyPrefix := d.Get(names.AttrBucket).(string), d.Get("key_prefix").(string)
+	id, err := flex.FlattenResourceId([]string{bucket, keyPrefix}, 2, true)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := directoryObjectsSync(ctx, d, meta); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Directory Objects (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceDirectoryObjectsRead(ctx, d, meta)...)
+}
+
+func resourceDirectoryObjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, optFns := directoryObjectsConn(ctx, d, meta)
+
+	bucket, keyPrefix := d.Get(names.AttrBucket).(string), sdkv1CompatibleCleanKey(d.Get("key_prefix").(string))
+	remote, err := findObjectETagsByBucketAndPrefix(ctx, conn, bucket, keyPrefix, optFns...)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Directory Objects (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Objects (%s): %s", d.Id(), err)
+	}
+
+	sourceETags := flex.ExpandStringValueMap(d.Get("source_etags").(map[string]interface{}))
+	etags := flex.ExpandStringValueMap(d.Get("etags").(map[string]interface{}))
+
+	// Objects that have been removed or whose content has changed outside of Terraform are dropped so that they are uploaded again.
+	newSourceETags, newETags := make(map[string]string), make(map[string]string)
+	for key, sourceETag := range sourceETags {
+		if etag, ok := remote[key]; ok && etag == etags[key] {
+			newSourceETags[key] = sourceETag
+			newETags[key] = etag
+		}
+	}
+
+	// Unmanaged objects are tracked so that the plan shows their removal.
+	if d.Get("delete_orphans").(bool) {
+		for key, etag := range remote {
+			if _, ok := sourceETags[key]; !ok {
+				newSourceETags[key] = etag
+				newETags[key] = etag
+			}
+		}
+	}
+
+	d.Set("etags", newETags)
+	d.Set("source_etags", newSourceETags)
+
+	return diags
+}
+
+func resourceDirectoryObjectsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := directoryObjectsSync(ctx, d, meta); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Directory Objects (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDirectoryObjectsRead(ctx, d, meta)...)
+}
+
+func resourceDirectoryObjectsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, optFns := directoryObjectsConn(ctx, d, meta)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	keys := slices.Sorted(maps.Keys(d.Get("source_etags").(map[string]interface{})))
+
+	log.Printf("[DEBUG] Deleting S3 Directory Objects (%s): %d objects", d.Id(), len(keys))
+	err := deleteObjectsByKey(ctx, conn, bucket, keys, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Objects (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceDirectoryObjectsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	sourceDir := d.Get("source_dir").(string)
+	if sourceDir == "" {
+		if err := d.SetNewComputed("source_etags"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("etags")
+	}
+
+	files, err := listDirectoryObjectFiles(sourceDir, d.Get("key_prefix").(string), flex.ExpandStringValueSet(d.Get("includes").(*schema.Set)), flex.ExpandStringValueSet(d.Get("excludes").(*schema.Set)))
+	if err != nil {
+		return err
+	}
+
+	o, _ := d.GetChange("source_etags")
+	oldSourceETags := flex.ExpandStringValueMap(o.(map[string]interface{}))
+	newSourceETags := make(map[string]string, len(files))
+	for key, file := range files {
+		newSourceETags[key] = file.etag
+	}
+
+	changed := !maps.Equal(oldSourceETags, newSourceETags)
+	if changed {
+		if err := d.SetNew("source_etags", newSourceETags); err != nil {
+			return err
+		}
+	}
+
+	if changed || hasDirectoryObjectsContentSettingsChanges(d) {
+		return d.SetNewComputed("etags")
+	}
+
+	return nil
+}
+
+// validateDirectoryObjectsKeyPrefix ensures that orphan deletion, which lists objects by plain string prefix,
+// only applies to a "folder" or to a whole bucket that has been chosen explicitly.
+func validateDirectoryObjectsKeyPrefix(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("delete_orphans").(bool) {
+		return nil
+	}
+
+	v := d.GetRawConfig().GetAttr("key_prefix")
+	if !v.IsKnown() {
+		return nil
+	}
+	if v.IsNull() {
+		return errors.New(`"key_prefix" must be set when "delete_orphans" is true; set it to "" to delete orphans from the whole bucket`)
+	}
+
+	if keyPrefix := v.AsString(); keyPrefix != "" && !strings.HasSuffix(keyPrefix, "/") {
+		return fmt.Errorf(`"key_prefix" (%s) must end in "/" when "delete_orphans" is true`, keyPrefix)
+	}
+
+	return nil
+}
+
+// hasDirectoryObjectsContentSettingsChanges returns whether any setting that applies to every uploaded object has changed.
+func hasDirectoryObjectsContentSettingsChanges(d interface{ HasChanges(...string) bool }) bool {
+	return d.HasChanges("acl", names.AttrKMSKeyID, names.AttrRule, "server_side_encryption", names.AttrStorageClass)
+}
+
+// directoryObjectsSync uploads new and changed files and deletes removed objects.
+func directoryObjectsSync(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	conn, optFns := directoryObjectsConn(ctx, d, meta)
+
+	bucket, keyPrefix := d.Get(names.AttrBucket).(string), sdkv1CompatibleCleanKey(d.Get("key_prefix").(string))
+	files, err := listDirectoryObjectFiles(d.Get("source_dir").(string), keyPrefix, flex.ExpandStringValueSet(d.Get("includes").(*schema.Set)), flex.ExpandStringValueSet(d.Get("excludes").(*schema.Set)))
+	if err != nil {
+		return err
+	}
+
+	o, _ := d.GetChange("source_etags")
+	oldSourceETags := flex.ExpandStringValueMap(o.(map[string]interface{}))
+	o, _ = d.GetChange("etags")
+	oldETags := flex.ExpandStringValueMap(o.(map[string]interface{}))
+
+	uploadAll := d.IsNewResource() || hasDirectoryObjectsContentSettingsChanges(d)
+
+	var toUpload []string
+	etags := make(map[string]string, len(files))
+	for _, key := range slices.Sorted(maps.Keys(files)) {
+		etag, ok := oldETags[key]
+		if uploadAll || !ok || oldSourceETags[key] != files[key].etag {
+			toUpload = append(toUpload, key)
+		} else {
+			etags[key] = etag
+		}
+	}
+
+	rules := expandDirectoryObjectsRules(d.Get(names.AttrRule).([]interface{}))
+	template := &s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+	}
+	if v, ok := d.GetOk("acl"); ok {
+		template.ACL = types.ObjectCannedACL(v.(string))
+	}
+	if v, ok := d.GetOk(names.AttrKMSKeyID); ok {
+		template.SSEKMSKeyId = aws.String(v.(string))
+		template.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+	}
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		template.ServerSideEncryption = types.ServerSideEncryption(v.(string))
+	}
+	if v, ok := d.GetOk(names.AttrStorageClass); ok {
+		template.StorageClass = types.StorageClass(v.(string))
+	}
+
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+	uploaded, err := uploadDirectoryObjects(ctx, uploader, template, files, toUpload, rules, d.Get("max_concurrency").(int))
+	maps.Copy(etags, uploaded)
+
+	// Record successful uploads even if others failed.
+	d.Set("etags", etags)
+	sourceETags := make(map[string]string, len(etags))
+	for key := range etags {
+		sourceETags[key] = files[key].etag
+	}
+	d.Set("source_etags", sourceETags)
+
+	if err != nil {
+		return err
+	}
+
+	// Delete objects that were previously uploaded, or that are unmanaged if orphans are to be deleted.
+	var toDelete []string
+	for key := range oldSourceETags {
+		if _, ok := files[key]; !ok {
+			toDelete = append(toDelete, key)
+		}
+	}
+	if d.Get("delete_orphans").(bool) {
+		remote, err := findObjectETagsByBucketAndPrefix(ctx, conn, bucket, keyPrefix, optFns...)
+		if err != nil {
+			return err
+		}
+
+		for key := range remote {
+			if _, ok := files[key]; !ok && !slices.Contains(toDelete, key) {
+				toDelete = append(toDelete, key)
+			}
+		}
+	}
+	slices.Sort(toDelete)
+
+	return deleteObjectsByKey(ctx, conn, bucket, toDelete, optFns...)
+}
+
+func directoryObjectsConn(ctx context.Context, d *schema.ResourceData, meta interface{}) (*s3.Client, []func(*s3.Options)) {
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	var optFns []func(*s3.Options)
+	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
+	if arn.IsARN(bucket) && conn.Options().Region == endpoints.AwsGlobalRegionID {
+		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	}
+
+	return conn, optFns
+}
+
+type directoryObjectFile struct {
+	etag string
+	name string
+	path string
+}
+
+// listDirectoryObjectFiles returns the files in sourceDir keyed by S3 object key.
+// Keys are cleaned in the same way as aws_s3_object keys so that they match the keys of the uploaded objects.
+func listDirectoryObjectFiles(sourceDir, keyPrefix string, includes, excludes []string) (map[string]directoryObjectFile, error) {
+	dir, err := homedir.Expand(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	fileNames, err := tfio.ListFiles(dir, includes, excludes)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]directoryObjectFile, len(fileNames))
+	for _, name := range fileNames {
+		filePath := filepath.Join(dir, filepath.FromSlash(name))
+
+		etag, err := objectETag(filePath)
+		if err != nil {
+			return nil, err
+		}
+
+		files[sdkv1CompatibleCleanKey(keyPrefix+name)] = directoryObjectFile{
+			etag: etag,
+			name: name,
+			path: filePath,
+		}
+	}
+
+	return files, nil
+}
+
+// objectETag returns the ETag that S3 reports for an unencrypted or SSE-S3 encrypted object uploaded from the file at path.
+// Files larger than the uploader's part size are uploaded in multiple parts and have an ETag of the form "<MD5 of part MD5s>-<part count>".
+func objectETag(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	size := fi.Size()
+	partSize := manager.DefaultUploadPartSize
+	if size/partSize >= int64(manager.MaxUploadParts) {
+		partSize = size/int64(manager.MaxUploadParts) + 1
+	}
+
+	if size <= partSize {
+		hash := md5.New()
+		if _, err := io.Copy(hash, f); err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(hash.Sum(nil)), nil
+	}
+
+	var sums []byte
+	var parts int
+	for {
+		hash := md5.New()
+		n, err := io.CopyN(hash, f, partSize)
+		if n > 0 {
+			sums = hash.Sum(sums)
+			parts++
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	hash := md5.Sum(sums)
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(hash[:]), parts), nil
+}
+
+type directoryObjectsRule struct {
+	cacheControl       string
+	contentDisposition string
+	contentEncoding    string
+	contentType        string
+	metadata           map[string]string
+	pattern            string
+}
+
+func expandDirectoryObjectsRules(tfList []interface{}) []directoryObjectsRule {
+	var rules []directoryObjectsRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rules = append(rules, directoryObjectsRule{
+			cacheControl:       tfMap["cache_control"].(string),
+			contentDisposition: tfMap["content_disposition"].(string),
+			contentEncoding:    tfMap["content_encoding"].(string),
+			contentType:        tfMap[names.AttrContentType].(string),
+			metadata:           flex.ExpandStringValueMap(tfMap["metadata"].(map[string]interface{})),
+			pattern:            tfMap["pattern"].(string),
+		})
+	}
+
+	return rules
+}
+
+// applyDirectoryObjectsRules sets object properties from every rule whose pattern matches the file name.
+// Rules are applied in order so later rules take precedence. Metadata is merged.
+func applyDirectoryObjectsRules(input *s3.PutObjectInput, name string, rules []directoryObjectsRule) {
+	for _, rule := range rules {
+		if ok, _ := tfio.MatchGlob(rule.pattern, name); !ok {
+			continue
+		}
+
+		if rule.cacheControl != "" {
+			input.CacheControl = aws.String(rule.cacheControl)
+		}
+		if rule.contentDisposition != "" {
+			input.ContentDisposition = aws.String(rule.contentDisposition)
+		}
+		if rule.contentEncoding != "" {
+			input.ContentEncoding = aws.String(rule.contentEncoding)
+		}
+		if rule.contentType != "" {
+			input.ContentType = aws.String(rule.contentType)
+		}
+		if len(rule.metadata) > 0 {
+			if input.Metadata == nil {
+				input.Metadata = make(map[string]string)
+			}
+			maps.Copy(input.Metadata, rule.metadata)
+		}
+	}
+}
+
+// detectContentType returns the MIME type of a file from its extension, falling back to sniffing its content.
+func detectContentType(name string, r io.ReadSeeker) (string, error) {
+	if v := mime.TypeByExtension(path.Ext(name)); v != "" {
+		return v, nil
+	}
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(r, buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(buf[:n]), nil
+}
+
+// uploadDirectoryObjects concurrently uploads the specified files and returns the ETags of the uploaded objects.
+func uploadDirectoryObjects(ctx context.Context, uploader *manager.Uploader, template *s3.PutObjectInput, files map[string]directoryObjectFile, keys []string, rules []directoryObjectsRule, maxConcurrency int) (map[string]string, error) {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		errs   []error
+		etags  = make(map[string]string, len(keys))
+		tokens = make(chan struct{}, maxConcurrency)
+	)
+
+	for _, key := range keys {
+		file := files[key]
+
+		wg.Add(1)
+		tokens <- struct{}{}
+		go func() {
+			defer func() {
+				<-tokens
+				wg.Done()
+			}()
+
+			etag, err := uploadDirectoryObject(ctx, uploader, template, key, file, rules)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("uploading S3 Object (%s): %w", key, err))
+				return
+			}
+
+			etags[key] = etag
+		}()
+	}
+
+	wg.Wait()
+
+	return etags, errors.Join(errs...)
+}
+
+func uploadDirectoryObject(ctx context.Context, uploader *manager.Uploader, template *s3.PutObjectInput, key string, file directoryObjectFile, rules []directoryObjectsRule) (string, error) {
+	f, err := os.Open(file.path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	input := *template
+	input.Body = f
+	input.Key = aws.String(key)
+
+	applyDirectoryObjectsRules(&input, file.name, rules)
+
+	if input.ContentType == nil {
+		contentType, err := detectContentType(file.name, f)
+		if err != nil {
+			return "", err
+		}
+
+		input.ContentType = aws.String(contentType)
+	}
+
+	output, err := uploader.Upload(ctx, &input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Trim(aws.ToString(output.ETag), `"`), nil
+}
+
+// findObjectETagsByBucketAndPrefix returns the ETags of the objects with the specified key prefix.
+func findObjectETagsByBucketAndPrefix(ctx context.Context, conn *s3.Client, bucket, prefix string, optFns ...func(*s3.Options)) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	etags := make(map[string]string)
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			etags[aws.ToString(v.Key)] = strings.Trim(aws.ToString(v.ETag), `"`)
+		}
+	}
+
+	return etags, nil
+}
+
+// deleteObjectsByKey deletes the specified objects in batches.
+func deleteObjectsByKey(ctx context.Context, conn *s3.Client, bucket string, keys []string, optFns ...func(*s3.Options)) error {
+	for chunk := range slices.Chunk(keys, directoryObjectsDeleteBatchSize) {
+		toDelete := tfslices.ApplyToAll(chunk, func(key string) types.ObjectIdentifier {
+			return types.ObjectIdentifier{
+				Key: aws.String(key),
+			}
+		})
+
+		if _, err := deletePage(ctx, conn, bucket, false, toDelete); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,583 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestObjectETag(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	testCases := []struct {
+		testName string
+		size     int64
+		expected string
+	}{
+		{
+			testName: "empty",
+			size:     0,
+			expected: "d41d8cd98f00b204e9800998ecf8427e",
+		},
+		{
+			testName: "single part",
+			size:     manager.DefaultUploadPartSize,
+			expected: "5f363e0e58a95f06cbe9bbc662c5dfb6",
+		},
+		{
+			testName: "multipart",
+			size:     manager.DefaultUploadPartSize + 1,
+			expected: "92f3a08aa3b1d7eb318ab9c2fc4a6ec3-2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(dir, strings.ReplaceAll(testCase.testName, " ", "_"))
+			if err := os.WriteFile(path, make([]byte, testCase.size), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tfs3.ObjectETag(path)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestListDirectoryObjectFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for _, name := range []string{"index.html", "assets/app.js"} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		testName  string
+		keyPrefix string
+		expected  []string
+	}{
+		{
+			testName: "no prefix",
+			expected: []string{"assets/app.js", "index.html"},
+		},
+		{
+			testName:  "prefix",
+			keyPrefix: "site/",
+			expected:  []string{"site/assets/app.js", "site/index.html"},
+		},
+		{
+			testName:  "unclean prefix",
+			keyPrefix: "/site//",
+			expected:  []string{"site/assets/app.js", "site/index.html"},
+		},
+		{
+			testName:  "relative prefix",
+			keyPrefix: "./",
+			expected:  []string{"assets/app.js", "index.html"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			files, err := tfs3.ListDirectoryObjectFiles(dir, testCase.keyPrefix, nil, nil)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := slices.Sorted(maps.Keys(files)); !slices.Equal(got, testCase.expected) {
+				t.Errorf("got %v, expected %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestDetectContentType(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			testName: "extension",
+			name:     "assets/site.css",
+			content:  "<html></html>",
+			expected: "text/css; charset=utf-8",
+		},
+		{
+			testName: "sniffed HTML",
+			name:     "index",
+			content:  "<html></html>",
+			expected: "text/html; charset=utf-8",
+		},
+		{
+			testName: "sniffed binary",
+			name:     "blob",
+			content:  "\x00\x01\x02",
+			expected: "application/octet-stream",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			r := bytes.NewReader([]byte(testCase.content))
+			got, err := tfs3.DetectContentType(testCase.name, r)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+
+			if r.Len() != len(testCase.content) {
+				t.Error("reader was not rewound")
+			}
+		})
+	}
+}
+
+func TestApplyDirectoryObjectsRules(t *testing.T) {
+	t.Parallel()
+
+	rules := tfs3.ExpandDirectoryObjectsRules([]interface{}{
+		map[string]interface{}{
+			"cache_control":       "max-age=300",
+			"content_disposition": "",
+			"content_encoding":    "",
+			names.AttrContentType: "",
+			"metadata":            map[string]interface{}{"owner": "web", "tier": "standard"},
+			"pattern":             "**",
+		},
+		map[string]interface{}{
+			"cache_control":       "no-cache",
+			"content_disposition": "",
+			"content_encoding":    "",
+			names.AttrContentType: "text/html",
+			"metadata":            map[string]interface{}{"tier": "entry"},
+			"pattern":             "*.html",
+		},
+	})
+
+	input := &s3.PutObjectInput{}
+	tfs3.ApplyDirectoryObjectsRules(input, "index.html", rules)
+
+	if got, expected := aws.ToString(input.CacheControl), "no-cache"; got != expected {
+		t.Errorf("CacheControl = %s, expected %s", got, expected)
+	}
+	if got, expected := aws.ToString(input.ContentType), "text/html"; got != expected {
+		t.Errorf("ContentType = %s, expected %s", got, expected)
+	}
+	if got, expected := input.Metadata, map[string]string{"owner": "web", "tier": "entry"}; fmt.Sprint(got) != fmt.Sprint(expected) {
+		t.Errorf("Metadata = %v, expected %v", got, expected)
+	}
+
+	input = &s3.PutObjectInput{}
+	tfs3.ApplyDirectoryObjectsRules(input, "assets/app.js", rules)
+
+	if got, expected := aws.ToString(input.CacheControl), "max-age=300"; got != expected {
+		t.Errorf("CacheControl = %s, expected %s", got, expected)
+	}
+	if input.ContentType != nil {
+		t.Errorf("ContentType = %s, expected nil", aws.ToString(input.ContentType))
+	}
+}
+
+func TestAccS3DirectoryObjects_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_objects.test"
+	sourceDir := testAccDirectoryObjectsSourceDir(t, map[string]string{
+		"index.html":    "<html></html>",
+		"css/site.css":  "body {}",
+		"js/app.js":     "console.log('app');",
+		"notes/todo.md": "ignored",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryObjectsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryObjectsConfig_basic(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryObjectsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrBucket, rName),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "source_etags.%", "3"),
+					resource.TestCheckResourceAttrPair(resourceName, "etags.site/index.html", resourceName, "source_etags.site/index.html"),
+					resource.TestCheckNoResourceAttr(resourceName, "source_etags.site/notes/todo.md"),
+					testAccCheckDirectoryObjectContentType(ctx, rName, "site/css/site.css", "text/css; charset=utf-8"),
+					testAccCheckDirectoryObjectContentType(ctx, rName, "site/index.html", "text/html"),
+				),
+			},
+			{
+				Config:   testAccDirectoryObjectsConfig_basic(rName, sourceDir),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					testAccDirectoryObjectsWriteFile(t, sourceDir, "js/app.js", "console.log('changed');")
+					testAccDirectoryObjectsWriteFile(t, sourceDir, "js/new.js", "console.log('new');")
+					if err := os.Remove(filepath.Join(sourceDir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectoryObjectsConfig_basic(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryObjectsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "source_etags.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "source_etags.site/js/new.js"),
+					resource.TestCheckNoResourceAttr(resourceName, "source_etags.site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectoryObjects_deleteOrphans(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_objects.test"
+	sourceDir := testAccDirectoryObjectsSourceDir(t, map[string]string{
+		"a.txt": "a",
+		"b.txt": "b",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryObjectsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryObjectsConfig_deleteOrphans(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryObjectsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source_etags.%", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectoryObjectsPutObject(ctx, t, rName, "orphan.txt")
+				},
+				Config: testAccDirectoryObjectsConfig_deleteOrphans(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryObjectsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source_etags.%", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "source_etags.orphan.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectoryObjects_deleteOrphansKeyPrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccDirectoryObjectsSourceDir(t, map[string]string{
+		"a.txt": "a",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryObjectsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDirectoryObjectsConfig_deleteOrphansNoKeyPrefix(rName, sourceDir),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`"key_prefix" must be set when "delete_orphans" is true`),
+			},
+			{
+				Config:      testAccDirectoryObjectsConfig_deleteOrphansKeyPrefix(rName, sourceDir, "site"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`"key_prefix" \(site\) must end in "/"`),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectoryObjects_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_objects.test"
+	sourceDir := testAccDirectoryObjectsSourceDir(t, map[string]string{
+		"a.txt": "a",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryObjectsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryObjectsConfig_deleteOrphans(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryObjectsExists(ctx, resourceName),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectoryObjectsPutObject(ctx, t, rName, "a.txt")
+				},
+				Config:             testAccDirectoryObjectsConfig_deleteOrphans(rName, sourceDir),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDirectoryObjectsConfig_deleteOrphans(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryObjectsExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "etags.a.txt", resourceName, "source_etags.a.txt"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectoryObjectsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		etags, err := tfs3.FindObjectETagsByBucketAndPrefix(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["key_prefix"])
+
+		if err != nil {
+			return err
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			key, ok := strings.CutPrefix(k, "etags.")
+			if !ok || key == "%" {
+				continue
+			}
+
+			if etag, ok := etags[key]; !ok {
+				return fmt.Errorf("S3 Object %s not found", key)
+			} else if etag != v {
+				return fmt.Errorf("S3 Object %s ETag = %s, expected %s", key, etag, v)
+			}
+		}
+
+		if got, expected := fmt.Sprint(len(etags)), rs.Primary.Attributes["etags.%"]; got != expected {
+			return fmt.Errorf("S3 Directory Objects (%s) object count = %s, expected %s", rs.Primary.ID, got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectoryObjectsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_objects" {
+				continue
+			}
+
+			etags, err := tfs3.FindObjectETagsByBucketAndPrefix(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["key_prefix"])
+
+			if err != nil {
+				return err
+			}
+
+			if len(etags) > 0 {
+				return fmt.Errorf("S3 Directory Objects %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectoryObjectContentType(ctx context.Context, bucket, key, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.ContentType); got != expected {
+			return fmt.Errorf("S3 Object %s Content-Type = %s, expected %s", key, got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectoryObjectsSourceDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		testAccDirectoryObjectsWriteFile(t, dir, name, content)
+	}
+
+	return dir
+}
+
+func testAccDirectoryObjectsWriteFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testAccDirectoryObjectsPutObject writes an object outside of Terraform.
+func testAccDirectoryObjectsPutObject(ctx context.Context, t *testing.T, bucket, key string) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+	_, err := conn.PutObject(ctx, &s3.PutObjectInput{
+		Body:   strings.NewReader("out-of-band"),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccDirectoryObjectsConfig_basic(rName, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory_objects" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[2]q
+  excludes   = ["notes"]
+
+  rule {
+    pattern       = "**"
+    cache_control = "max-age=300"
+  }
+
+  rule {
+    pattern       = "*.html"
+    cache_control = "no-cache"
+    content_type  = "text/html"
+
+    metadata = {
+      entry = "true"
+    }
+  }
+}
+`, rName, sourceDir)
+}
+
+func testAccDirectoryObjectsConfig_deleteOrphans(rName, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory_objects" "test" {
+  bucket          = aws_s3_bucket.test.bucket
+  source_dir      = %[2]q
+  key_prefix      = ""
+  delete_orphans  = true
+  max_concurrency = 2
+}
+`, rName, sourceDir)
+}
+
+func testAccDirectoryObjectsConfig_deleteOrphansKeyPrefix(rName, sourceDir, keyPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory_objects" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  source_dir     = %[2]q
+  key_prefix     = %[3]q
+  delete_orphans = true
+}
+`, rName, sourceDir, keyPrefix)
+}
+
+func testAccDirectoryObjectsConfig_deleteOrphansNoKeyPrefix(rName, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory_objects" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  source_dir     = %[2]q
+  delete_orphans = true
+}
+`, rName, sourceDir)
+}
@@ -26,13 +26,17 @@ var (
 	ResourceBucketVersioning                        = resourceBucketVersioning
 	ResourceBucketWebsiteConfiguration              = resourceBucketWebsiteConfiguration
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
+	ResourceDirectoryObjects                        = resourceDirectoryObjects
 	ResourceObjectCopy                              = resourceObjectCopy
 
+	ApplyDirectoryObjectsRules            = applyDirectoryObjectsRules
 	BucketUpdateTags                      = bucketUpdateTags
 	BucketRegionalDomainName              = bucketRegionalDomainName
 	BucketWebsiteEndpointAndDomain        = bucketWebsiteEndpointAndDomain
 	DeleteAllObjectVersions               = deleteAllObjectVersions
+	DetectContentType                     = detectContentType
 	EmptyBucket                           = emptyBucket
+	ExpandDirectoryObjectsRules           = expandDirectoryObjectsRules
 	FindAnalyticsConfiguration            = findAnalyticsConfiguration
 	FindBucket                            = findBucket
 	FindBucketACL                         = findBucketACL
@@ -49,6 +53,7 @@ var (
 	FindLoggingEnabled                    = findLoggingEnabled
 	FindMetricsConfiguration              = findMetricsConfiguration
 	FindObjectByBucketAndKey              = findObjectByBucketAndKey
+	FindObjectETagsByBucketAndPrefix      = findObjectETagsByBucketAndPrefix
 	FindObjectLockConfiguration           = findObjectLockConfiguration
 	FindOwnershipControls                 = findOwnershipControls
 	FindPublicAccessBlockConfiguration    = findPublicAccessBlockConfiguration
@@ -56,6 +61,8 @@ var (
 	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
 	HostedZoneIDForRegion                 = hostedZoneIDForRegion
 	IsDirectoryBucket                     = isDirectoryBucket
+	ListDirectoryObjectFiles              = listDirectoryObjectFiles
+	ObjectETag                            = objectETag
 	ObjectListTags                        = objectListTags
 	ObjectUpdateTags                      = objectUpdateTags
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
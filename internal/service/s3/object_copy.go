@@ -233,7 +233,7 @@ func resourceObjectCopy() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 				Sensitive:    true,
 			},
 			"last_modified": {
@@ -197,6 +197,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_bucket_website_configuration",
 			Name:     "Bucket Website Configuration",
 		},
+		{
+			Factory:  resourceDirectoryObjects,
+			TypeName: "aws_s3_directory_objects",
+			Name:     "Directory Objects",
+		},
 		{
 			Factory:  resourceObject,
 			TypeName: "aws_s3_object",
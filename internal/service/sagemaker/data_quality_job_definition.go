@@ -278,7 +278,7 @@ func resourceDataQualityJobDefinition() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidKMSKeyARN,
 						},
 						"monitoring_outputs": {
 							Type:     schema.TypeList,
@@ -429,7 +429,7 @@ func resourceDataQualityJobDefinition() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"stopping_condition": {
 				Type:     schema.TypeList,
@@ -73,7 +73,7 @@ func resourceDeviceFleet() *schema.Resource {
 						names.AttrKMSKeyID: {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidKMSKeyARN,
 						},
 						"s3_output_location": {
 							Type:         schema.TypeString,
@@ -86,7 +86,7 @@ func resourceDeviceFleet() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
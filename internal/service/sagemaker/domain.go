@@ -482,7 +482,7 @@ func resourceDomain() *schema.Resource {
 												names.AttrExecutionRoleARN: {
 													Type:         schema.TypeString,
 													Optional:     true,
-													ValidateFunc: verify.ValidARN,
+													ValidateFunc: verify.ValidIAMRoleARN,
 												},
 												names.AttrStatus: {
 													Type:             schema.TypeString,
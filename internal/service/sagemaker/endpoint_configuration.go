@@ -82,7 +82,7 @@ func resourceEndpointConfiguration() *schema.Resource {
 										Type:         schema.TypeString,
 										Optional:     true,
 										ForceNew:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidKMSKeyARN,
 									},
 									"notification_config": {
 										Type:     schema.TypeList,
@@ -225,7 +225,7 @@ func resourceEndpointConfiguration() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidKMSKeyARN,
 						},
 					},
 				},
@@ -291,7 +291,7 @@ func resourceEndpointConfiguration() *schema.Resource {
 										Type:         schema.TypeString,
 										Optional:     true,
 										ForceNew:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidKMSKeyARN,
 									},
 								},
 							},
@@ -463,7 +463,7 @@ func resourceEndpointConfiguration() *schema.Resource {
 										Type:         schema.TypeString,
 										Required:     true,
 										ForceNew:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidKMSKeyARN,
 									},
 								},
 							},
@@ -49,7 +49,7 @@ func resourceMlflowTrackingServer() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"tracking_server_name": {
 				Type:     schema.TypeString,
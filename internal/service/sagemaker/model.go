@@ -201,7 +201,7 @@ func resourceModel() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"inference_execution_config": {
 				Type:     schema.TypeList,
@@ -129,7 +129,7 @@ func resourceNotebookInstance() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"root_access": {
 				Type:             schema.TypeString,
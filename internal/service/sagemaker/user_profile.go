@@ -119,7 +119,7 @@ func resourceUserProfile() *schema.Resource {
 												names.AttrExecutionRoleARN: {
 													Type:         schema.TypeString,
 													Optional:     true,
-													ValidateFunc: verify.ValidARN,
+													ValidateFunc: verify.ValidIAMRoleARN,
 												},
 												names.AttrStatus: {
 													Type:             schema.TypeString,
@@ -37,3 +37,20 @@ func findScheduleGroupByName(ctx context.Context, conn *scheduler.Client, name s
 
 	return out, nil
 }
+
+func findSchedules(ctx context.Context, conn *scheduler.Client, input *scheduler.ListSchedulesInput) ([]types.ScheduleSummary, error) {
+	var output []types.ScheduleSummary
+
+	pages := scheduler.NewListSchedulesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Schedules...)
+	}
+
+	return output, nil
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -115,9 +116,12 @@ func resourceSchedule() *schema.Resource {
 				)),
 			},
 			names.AttrScheduleExpression: {
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+					validation.StringLenBetween(1, 256),
+					verify.ValidScheduleExpression(schedule.KindAt, schedule.KindCron, schedule.KindRate),
+				)),
 			},
 			"schedule_expression_timezone": {
 				Type:             schema.TypeString,
@@ -375,7 +379,7 @@ func resourceSchedule() *schema.Resource {
 						names.AttrRoleARN: {
 							Type:             schema.TypeString,
 							Required:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+							ValidateDiagFunc: validation.ToDiagFunc(verify.ValidIAMRoleARN),
 						},
 						"sagemaker_pipeline_parameters": {
 							Type:     schema.TypeList,
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_scheduler_schedule", name="Schedule")
+func dataSourceSchedule() *schema.Resource {
+	resourceSchema := resourceSchedule().Schema
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceScheduleRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flexible_time_window": sdkv2.DataSourcePropertyFromResourceProperty(resourceSchema["flexible_time_window"]),
+			names.AttrGroupName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "default",
+			},
+			names.AttrKMSKeyARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrScheduleExpression: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schedule_expression_timezone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTarget: sdkv2.DataSourcePropertyFromResourceProperty(resourceSchema[names.AttrTarget]),
+		},
+	}
+}
+
+func dataSourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { // nosemgrep:ci.scheduler-in-func-name
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	groupName, scheduleName := d.Get(names.AttrGroupName).(string), d.Get(names.AttrName).(string)
+	out, err := findScheduleByTwoPartKey(ctx, conn, groupName, scheduleName)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EventBridge Scheduler Schedule", err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", groupName, scheduleName))
+	d.Set(names.AttrARN, out.Arn)
+	d.Set(names.AttrDescription, out.Description)
+	if out.EndDate != nil {
+		d.Set("end_date", aws.ToTime(out.EndDate).Format(time.RFC3339))
+	} else {
+		d.Set("end_date", nil)
+	}
+	if err := d.Set("flexible_time_window", []interface{}{flattenFlexibleTimeWindow(out.FlexibleTimeWindow)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting flexible_time_window: %s", err)
+	}
+	d.Set(names.AttrGroupName, out.GroupName)
+	d.Set(names.AttrKMSKeyARN, out.KmsKeyArn)
+	d.Set(names.AttrName, out.Name)
+	d.Set(names.AttrScheduleExpression, out.ScheduleExpression)
+	d.Set("schedule_expression_timezone", out.ScheduleExpressionTimezone)
+	if out.StartDate != nil {
+		d.Set("start_date", aws.ToTime(out.StartDate).Format(time.RFC3339))
+	} else {
+		d.Set("start_date", nil)
+	}
+	d.Set(names.AttrState, out.State)
+	if err := d.Set(names.AttrTarget, []interface{}{flattenTarget(ctx, out.Target)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting target: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSchedulerScheduleDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_scheduler_schedule.test"
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleDataSourceConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrDescription, resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(dataSourceName, "flexible_time_window.#", resourceName, "flexible_time_window.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "flexible_time_window.0.mode", resourceName, "flexible_time_window.0.mode"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrGroupName, resourceName, names.AttrGroupName),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrScheduleExpression, resourceName, names.AttrScheduleExpression),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schedule_expression_timezone", resourceName, "schedule_expression_timezone"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrState, resourceName, names.AttrState),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target.#", resourceName, "target.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target.0.arn", resourceName, "target.0.arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target.0.role_arn", resourceName, "target.0.role_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target.0.retry_policy.0.maximum_retry_attempts", resourceName, "target.0.retry_policy.0.maximum_retry_attempts"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerScheduleDataSource_groupName(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_scheduler_schedule.test"
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleDataSourceConfig_groupName(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrGroupName, "aws_scheduler_schedule_group.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccScheduleDataSourceConfig_basic(name string) string {
+	return acctest.ConfigCompose(testAccScheduleConfig_basic(name), `
+data "aws_scheduler_schedule" "test" {
+  name = aws_scheduler_schedule.test.name
+}
+`)
+}
+
+func testAccScheduleDataSourceConfig_groupName(name string) string {
+	return acctest.ConfigCompose(testAccScheduleConfig_groupName(name), fmt.Sprintf(`
+data "aws_scheduler_schedule" "test" {
+  group_name = aws_scheduler_schedule.test.group_name
+  name       = %[1]q
+}
+`, name))
+}
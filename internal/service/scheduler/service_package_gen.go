@@ -23,7 +23,26 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceSchedule,
+			TypeName: "aws_scheduler_schedule",
+			Name:     "Schedule",
+		},
+		{
+			Factory:  dataSourceScheduleGroup,
+			TypeName: "aws_scheduler_schedule_group",
+			Name:     "Schedule Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  dataSourceSchedules,
+			TypeName: "aws_scheduler_schedules",
+			Name:     "Schedules",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
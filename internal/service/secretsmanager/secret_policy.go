@@ -8,6 +8,7 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,11 +19,18 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// secretPolicyValidation validates secret resource policies with IAM Access Analyzer.
+var secretPolicyValidation = &tfaccessanalyzer.PolicyValidation{
+	Attribute:  names.AttrPolicy,
+	PolicyType: accessanalyzertypes.PolicyTypeResourcePolicy,
+}
+
 // @SDKResource("aws_secretsmanager_secret_policy", name="Secret Policy")
 func resourceSecretPolicy() *schema.Resource {
 	return &schema.Resource{
@@ -58,6 +66,8 @@ func resourceSecretPolicy() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 		},
+
+		CustomizeDiff: secretPolicyValidation.CustomizeDiff,
 	}
 }
 
@@ -95,6 +105,8 @@ func resourceSecretPolicyCreate(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "waiting for Secrets Manager Secret Policy (%s) create: %s", d.Id(), err)
 	}
 
+	diags = append(diags, secretPolicyValidation.Warnings(ctx, d, meta)...)
+
 	return append(diags, resourceSecretPolicyRead(ctx, d, meta)...)
 }
 
@@ -151,6 +163,8 @@ func resourceSecretPolicyUpdate(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	diags = append(diags, secretPolicyValidation.Warnings(ctx, d, meta)...)
+
 	return append(diags, resourceSecretPolicyRead(ctx, d, meta)...)
 }
 
@@ -31,6 +31,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -159,6 +160,9 @@ func (r *dataLakeResource) Schema(ctx context.Context, request resource.SchemaRe
 									names.AttrRoleARN: schema.StringAttribute{
 										CustomType: fwtypes.ARNType,
 										Optional:   true,
+										Validators: []validator.String{
+											fwvalidators.IAMRoleARN(),
+										},
 									},
 								},
 							},
@@ -97,7 +97,7 @@ func resourceEventDestination() *schema.Resource {
 						names.AttrRoleARN: {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidIAMRoleARN,
 						},
 						names.AttrStreamARN: {
 							Type:         schema.TypeString,
@@ -12,4 +12,8 @@ var (
 	FindActivityByARN     = findActivityByARN
 	FindAliasByARN        = findAliasByARN
 	FindStateMachineByARN = findStateMachineByARN
+
+	ValidateStateMachineDefinition = validateStateMachineDefinition
 )
+
+type StateMachineDefinition = stateMachineDefinition
@@ -39,6 +39,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_sfn_state_machine",
 			Name:     "State Machine",
 		},
+		{
+			Factory:  dataSourceStateMachineDefinitionDocument,
+			TypeName: "aws_sfn_state_machine_definition_document",
+			Name:     "State Machine Definition Document",
+		},
 		{
 			Factory:  dataSourceStateMachineVersions,
 			TypeName: "aws_sfn_state_machine_versions",
@@ -62,9 +62,10 @@ func resourceStateMachine() *schema.Resource {
 				Computed: true,
 			},
 			"definition": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1024*1024), // 1048576
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringLenBetween(0, 1024*1024), // 1048576
+				DiffSuppressFunc: verify.SuppressEquivalentStateMachineDefinitionDiffs,
 			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
@@ -153,7 +154,7 @@ func resourceStateMachine() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"state_machine_version_arn": {
 				Type:     schema.TypeString,
@@ -0,0 +1,931 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	stateMachineDefinitionStateNameMaxLength = 80
+	stateMachineDefinitionErrorAll           = "States.ALL"
+)
+
+// @SDKDataSource("aws_sfn_state_machine_definition_document", name="State Machine Definition Document")
+func dataSourceStateMachineDefinitionDocument() *schema.Resource {
+	jsonSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStateMachineDefinitionDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrJSON: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_language": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[stateMachineDefinitionQueryLanguage](),
+			},
+			"start_at": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arguments": jsonSchema(),
+						"assign":    jsonSchema(),
+						"branches": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsJSON,
+							},
+						},
+						"catch": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"assign": jsonSchema(),
+									"error_equals": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"next": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"output": jsonSchema(),
+									"result_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"cause": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"cause_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"choice": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCondition: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"next": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"output": jsonSchema(),
+									names.AttrRule: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsJSON,
+									},
+								},
+							},
+						},
+						names.AttrComment: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"default": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"end": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"error_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"heartbeat_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"input_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"item_processor": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"definition": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsJSON,
+									},
+									"execution_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[stateMachineDefinitionExecutionType](),
+									},
+									names.AttrMode: {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          string(stateMachineDefinitionProcessorModeInline),
+										ValidateDiagFunc: enum.Validate[stateMachineDefinitionProcessorMode](),
+									},
+								},
+							},
+						},
+						"item_reader":   jsonSchema(),
+						"item_selector": jsonSchema(),
+						"items":         jsonSchema(),
+						"items_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"max_concurrency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, stateMachineDefinitionStateNameMaxLength),
+						},
+						"next": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"output": jsonSchema(),
+						"output_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrParameters: jsonSchema(),
+						"query_language": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[stateMachineDefinitionQueryLanguage](),
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"result": jsonSchema(),
+						"result_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"result_selector": jsonSchema(),
+						"result_writer":   jsonSchema(),
+						"retry": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"backoff_rate": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatAtLeast(1),
+									},
+									"error_equals": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"interval_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"jitter_strategy": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[stateMachineDefinitionJitterStrategy](),
+									},
+									"max_attempts": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      3,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"max_delay_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"seconds_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"timeout_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"timestamp": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidUTCTimestamp,
+						},
+						"timestamp_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[stateMachineDefinitionStateType](),
+						},
+					},
+				},
+			},
+			"timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			names.AttrVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceStateMachineDefinitionDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	definition := &stateMachineDefinition{
+		StartAt: d.Get("start_at").(string),
+		States:  make(map[string]*stateMachineDefinitionState),
+	}
+
+	if v, ok := d.GetOk(names.AttrComment); ok {
+		definition.Comment = v.(string)
+	}
+	if v, ok := d.GetOk("query_language"); ok {
+		definition.QueryLanguage = v.(string)
+	}
+	if v, ok := d.GetOk("timeout_seconds"); ok {
+		definition.TimeoutSeconds = v.(int)
+	}
+	if v, ok := d.GetOk(names.AttrVersion); ok {
+		definition.Version = v.(string)
+	}
+
+	for _, tfMapRaw := range d.Get(names.AttrState).([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		if _, ok := definition.States[name]; ok {
+			return sdkdiag.AppendErrorf(diags, "duplicate state name: %s", name)
+		}
+
+		state, err := expandStateMachineDefinitionState(tfMap)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "state %q: %s", name, err)
+		}
+
+		definition.States[name] = state
+	}
+
+	if err := validateStateMachineDefinition(definition, "", false); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Disable HTML escaping so that JSONata expressions such as "{% $a < $b %}" are rendered verbatim.
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(definition); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	jsonString := strings.TrimSuffix(buf.String(), "\n")
+
+	d.Set(names.AttrJSON, jsonString)
+
+	d.SetId(strconv.Itoa(schema.HashString(jsonString)))
+
+	return diags
+}
+
+func expandStateMachineDefinitionState(tfMap map[string]interface{}) (*stateMachineDefinitionState, error) {
+	apiObject := &stateMachineDefinitionState{
+		Type: tfMap[names.AttrType].(string),
+	}
+
+	jsonAttrs := map[string]*interface{}{
+		"arguments":          &apiObject.Arguments,
+		"assign":             &apiObject.Assign,
+		"item_reader":        &apiObject.ItemReader,
+		"item_selector":      &apiObject.ItemSelector,
+		"items":              &apiObject.Items,
+		"output":             &apiObject.Output,
+		names.AttrParameters: &apiObject.Parameters,
+		"result":             &apiObject.Result,
+		"result_selector":    &apiObject.ResultSelector,
+		"result_writer":      &apiObject.ResultWriter,
+	}
+	for k, p := range jsonAttrs {
+		v, err := expandStateMachineDefinitionJSON(tfMap[k].(string))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+
+		*p = v
+	}
+
+	stringAttrs := map[string]*string{
+		"cause":           &apiObject.Cause,
+		"cause_path":      &apiObject.CausePath,
+		names.AttrComment: &apiObject.Comment,
+		"default":         &apiObject.Default,
+		"error":           &apiObject.Error,
+		"error_path":      &apiObject.ErrorPath,
+		"input_path":      &apiObject.InputPath,
+		"items_path":      &apiObject.ItemsPath,
+		"next":            &apiObject.Next,
+		"output_path":     &apiObject.OutputPath,
+		"query_language":  &apiObject.QueryLanguage,
+		"resource":        &apiObject.Resource,
+		"result_path":     &apiObject.ResultPath,
+		"seconds_path":    &apiObject.SecondsPath,
+		"timestamp":       &apiObject.Timestamp,
+		"timestamp_path":  &apiObject.TimestampPath,
+	}
+	for k, p := range stringAttrs {
+		*p = tfMap[k].(string)
+	}
+
+	apiObject.End = tfMap["end"].(bool)
+	apiObject.HeartbeatSeconds = tfMap["heartbeat_seconds"].(int)
+	apiObject.MaxConcurrency = tfMap["max_concurrency"].(int)
+	apiObject.Seconds = tfMap["seconds"].(int)
+	apiObject.TimeoutSeconds = tfMap["timeout_seconds"].(int)
+
+	for _, v := range tfMap["branches"].([]interface{}) {
+		branch, err := expandStateMachineDefinitionJSON(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("branches: %w", err)
+		}
+
+		apiObject.Branches = append(apiObject.Branches, branch)
+	}
+
+	for _, tfMapRaw := range tfMap["catch"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		catcher := &stateMachineDefinitionCatcher{
+			ErrorEquals: flex.ExpandStringValueList(tfMap["error_equals"].([]interface{})),
+			Next:        tfMap["next"].(string),
+			ResultPath:  tfMap["result_path"].(string),
+		}
+
+		var err error
+		if catcher.Assign, err = expandStateMachineDefinitionJSON(tfMap["assign"].(string)); err != nil {
+			return nil, fmt.Errorf("catch assign: %w", err)
+		}
+		if catcher.Output, err = expandStateMachineDefinitionJSON(tfMap["output"].(string)); err != nil {
+			return nil, fmt.Errorf("catch output: %w", err)
+		}
+
+		apiObject.Catch = append(apiObject.Catch, catcher)
+	}
+
+	for _, tfMapRaw := range tfMap["choice"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// A JSONPath choice rule is a comparison object to which the transition is added.
+		choice := make(map[string]interface{})
+		if v := tfMap[names.AttrRule].(string); v != "" {
+			rule, err := expandStateMachineDefinitionJSON(v)
+			if err != nil {
+				return nil, fmt.Errorf("choice rule: %w", err)
+			}
+
+			if choice, ok = rule.(map[string]interface{}); !ok {
+				return nil, errors.New("choice rule must be a JSON object")
+			}
+		}
+		if v := tfMap[names.AttrCondition].(string); v != "" {
+			choice["Condition"] = v
+		}
+		if v := tfMap["output"].(string); v != "" {
+			output, err := expandStateMachineDefinitionJSON(v)
+			if err != nil {
+				return nil, fmt.Errorf("choice output: %w", err)
+			}
+
+			choice["Output"] = output
+		}
+		choice["Next"] = tfMap["next"].(string)
+
+		apiObject.Choices = append(apiObject.Choices, choice)
+	}
+
+	if v, ok := tfMap["item_processor"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		definition, err := expandStateMachineDefinitionJSON(tfMap["definition"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("item_processor definition: %w", err)
+		}
+
+		itemProcessor, ok := definition.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("item_processor definition must be a JSON object")
+		}
+
+		processorConfig := map[string]interface{}{
+			"Mode": tfMap[names.AttrMode].(string),
+		}
+		if v := tfMap["execution_type"].(string); v != "" {
+			processorConfig["ExecutionType"] = v
+		}
+		itemProcessor["ProcessorConfig"] = processorConfig
+
+		apiObject.ItemProcessor = itemProcessor
+	}
+
+	for _, tfMapRaw := range tfMap["retry"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		maxAttempts := tfMap["max_attempts"].(int)
+		apiObject.Retry = append(apiObject.Retry, &stateMachineDefinitionRetrier{
+			BackoffRate:     tfMap["backoff_rate"].(float64),
+			ErrorEquals:     flex.ExpandStringValueList(tfMap["error_equals"].([]interface{})),
+			IntervalSeconds: tfMap["interval_seconds"].(int),
+			JitterStrategy:  tfMap["jitter_strategy"].(string),
+			MaxAttempts:     &maxAttempts,
+			MaxDelaySeconds: tfMap["max_delay_seconds"].(int),
+		})
+	}
+
+	return apiObject, nil
+}
+
+// expandStateMachineDefinitionJSON decodes an optional JSON attribute value.
+func expandStateMachineDefinitionJSON(s string) (interface{}, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// validateStateMachineDefinition performs offline structural validation of a state machine definition.
+// Parallel branches and Map item processors are validated recursively.
+func validateStateMachineDefinition(definition *stateMachineDefinition, queryLanguage string, nested bool) error {
+	var errs []error
+
+	queryLanguage, err := stateMachineDefinitionEffectiveQueryLanguage(definition.QueryLanguage, queryLanguage)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if nested && (definition.TimeoutSeconds != 0 || definition.Version != "") {
+		errs = append(errs, errors.New("TimeoutSeconds and Version can only be set at the top level of a state machine"))
+	}
+
+	if len(definition.States) == 0 {
+		return errors.Join(append(errs, errors.New("at least one state must be defined"))...)
+	}
+	if definition.StartAt == "" {
+		errs = append(errs, errors.New("StartAt must be set"))
+	} else if _, ok := definition.States[definition.StartAt]; !ok {
+		errs = append(errs, fmt.Errorf("StartAt references undefined state %q", definition.StartAt))
+	}
+
+	stateNames := slices.Sorted(maps.Keys(definition.States))
+
+	for _, name := range stateNames {
+		for _, err := range validateStateMachineDefinitionState(definition, definition.States[name], queryLanguage) {
+			errs = append(errs, fmt.Errorf("state %q: %w", name, err))
+		}
+	}
+
+	// Every state must be reachable from StartAt and must be able to reach a terminal state.
+	reachable := stateMachineDefinitionReachable([]string{definition.StartAt}, func(name string) []string {
+		return stateMachineDefinitionTransitions(definition.States[name])
+	})
+
+	var terminals []string
+	predecessors := make(map[string][]string)
+	for _, name := range stateNames {
+		state := definition.States[name]
+
+		if stateMachineDefinitionStateIsTerminal(state) {
+			terminals = append(terminals, name)
+		}
+		for _, next := range stateMachineDefinitionTransitions(state) {
+			predecessors[next] = append(predecessors[next], name)
+		}
+	}
+
+	terminating := stateMachineDefinitionReachable(terminals, func(name string) []string {
+		return predecessors[name]
+	})
+
+	if len(terminals) == 0 {
+		errs = append(errs, errors.New("no terminal state: at least one state must set End or be a Succeed or Fail state"))
+	}
+
+	for _, name := range stateNames {
+		if _, ok := reachable[name]; !ok {
+			errs = append(errs, fmt.Errorf("state %q is not reachable from StartAt", name))
+		} else if _, ok := terminating[name]; !ok && len(terminals) > 0 {
+			errs = append(errs, fmt.Errorf("state %q cannot reach a terminal state", name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateStateMachineDefinitionState(definition *stateMachineDefinition, state *stateMachineDefinitionState, queryLanguage string) []error {
+	var errs []error
+
+	stateType := stateMachineDefinitionStateType(state.Type)
+	if !slices.Contains(enum.EnumValues[stateMachineDefinitionStateType](), stateType) {
+		return []error{fmt.Errorf("unsupported Type %q", state.Type)}
+	}
+
+	queryLanguage, err := stateMachineDefinitionEffectiveQueryLanguage(state.QueryLanguage, queryLanguage)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	isDefined := func(name string) bool {
+		_, ok := definition.States[name]
+		return ok
+	}
+	isType := func(types ...stateMachineDefinitionStateType) bool {
+		return slices.Contains(types, stateType)
+	}
+	fieldsOnlyFor := func(set map[string]bool, types ...stateMachineDefinitionStateType) {
+		if isType(types...) {
+			return
+		}
+		for _, field := range slices.Sorted(maps.Keys(set)) {
+			if set[field] {
+				errs = append(errs, fmt.Errorf("%s cannot be set in a %s state", field, state.Type))
+			}
+		}
+	}
+
+	// Transitions.
+	switch {
+	case isType(stateMachineDefinitionStateTypeChoice, stateMachineDefinitionStateTypeFail, stateMachineDefinitionStateTypeSucceed):
+		if state.Next != "" || state.End {
+			errs = append(errs, fmt.Errorf("Next and End cannot be set in a %s state", state.Type))
+		}
+	case state.Next != "" && state.End:
+		errs = append(errs, errors.New("only one of Next or End can be set"))
+	case state.Next == "" && !state.End:
+		errs = append(errs, errors.New("one of Next or End must be set"))
+	}
+	if state.Next != "" && !isDefined(state.Next) {
+		errs = append(errs, fmt.Errorf("Next references undefined state %q", state.Next))
+	}
+
+	// Query language specific fields.
+	jsonPathFields := map[string]bool{
+		"CausePath":      state.CausePath != "",
+		"ErrorPath":      state.ErrorPath != "",
+		"InputPath":      state.InputPath != "",
+		"ItemsPath":      state.ItemsPath != "",
+		"OutputPath":     state.OutputPath != "",
+		"Parameters":     state.Parameters != nil,
+		"ResultPath":     state.ResultPath != "" || slices.ContainsFunc(state.Catch, func(v *stateMachineDefinitionCatcher) bool { return v.ResultPath != "" }),
+		"ResultSelector": state.ResultSelector != nil,
+		"SecondsPath":    state.SecondsPath != "",
+		"TimestampPath":  state.TimestampPath != "",
+	}
+	jsonataFields := map[string]bool{
+		"Arguments": state.Arguments != nil,
+		"Items":     state.Items != nil,
+		"Output":    state.Output != nil || slices.ContainsFunc(state.Catch, func(v *stateMachineDefinitionCatcher) bool { return v.Output != nil }),
+	}
+	switch stateMachineDefinitionQueryLanguage(queryLanguage) {
+	case stateMachineDefinitionQueryLanguageJSONata:
+		for _, field := range slices.Sorted(maps.Keys(jsonPathFields)) {
+			if jsonPathFields[field] {
+				errs = append(errs, fmt.Errorf("%s cannot be used with the JSONata query language", field))
+			}
+		}
+	default:
+		for _, field := range slices.Sorted(maps.Keys(jsonataFields)) {
+			if jsonataFields[field] {
+				errs = append(errs, fmt.Errorf("%s cannot be used with the JSONPath query language", field))
+			}
+		}
+	}
+
+	// Type specific fields.
+	fieldsOnlyFor(map[string]bool{
+		"HeartbeatSeconds": state.HeartbeatSeconds != 0,
+		"Resource":         state.Resource != "",
+		"TimeoutSeconds":   state.TimeoutSeconds != 0,
+	}, stateMachineDefinitionStateTypeTask)
+	fieldsOnlyFor(map[string]bool{
+		"Catch": len(state.Catch) > 0,
+		"Retry": len(state.Retry) > 0,
+	}, stateMachineDefinitionStateTypeMap, stateMachineDefinitionStateTypeParallel, stateMachineDefinitionStateTypeTask)
+	fieldsOnlyFor(map[string]bool{
+		"Choices": len(state.Choices) > 0,
+		"Default": state.Default != "",
+	}, stateMachineDefinitionStateTypeChoice)
+	fieldsOnlyFor(map[string]bool{
+		"Seconds":       state.Seconds != 0,
+		"SecondsPath":   state.SecondsPath != "",
+		"Timestamp":     state.Timestamp != "",
+		"TimestampPath": state.TimestampPath != "",
+	}, stateMachineDefinitionStateTypeWait)
+	fieldsOnlyFor(map[string]bool{
+		"Result": state.Result != nil,
+	}, stateMachineDefinitionStateTypePass)
+	fieldsOnlyFor(map[string]bool{
+		"Cause":     state.Cause != "",
+		"CausePath": state.CausePath != "",
+		"Error":     state.Error != "",
+		"ErrorPath": state.ErrorPath != "",
+	}, stateMachineDefinitionStateTypeFail)
+	fieldsOnlyFor(map[string]bool{
+		"Branches": len(state.Branches) > 0,
+	}, stateMachineDefinitionStateTypeParallel)
+	fieldsOnlyFor(map[string]bool{
+		"ItemProcessor":  state.ItemProcessor != nil,
+		"ItemReader":     state.ItemReader != nil,
+		"ItemSelector":   state.ItemSelector != nil,
+		"Items":          state.Items != nil,
+		"ItemsPath":      state.ItemsPath != "",
+		"MaxConcurrency": state.MaxConcurrency != 0,
+		"ResultWriter":   state.ResultWriter != nil,
+	}, stateMachineDefinitionStateTypeMap)
+
+	switch stateType {
+	case stateMachineDefinitionStateTypeTask:
+		if state.Resource == "" {
+			errs = append(errs, errors.New("Resource must be set in a Task state"))
+		}
+	case stateMachineDefinitionStateTypeChoice:
+		if len(state.Choices) == 0 {
+			errs = append(errs, errors.New("at least one choice rule must be defined in a Choice state"))
+		}
+		for i, choice := range state.Choices {
+			next, _ := choice["Next"].(string)
+			if !isDefined(next) {
+				errs = append(errs, fmt.Errorf("choice rule %d: Next references undefined state %q", i, next))
+			}
+
+			_, hasCondition := choice["Condition"]
+			if queryLanguage == string(stateMachineDefinitionQueryLanguageJSONata) {
+				if !hasCondition {
+					errs = append(errs, fmt.Errorf("choice rule %d: Condition must be set with the JSONata query language", i))
+				}
+				for _, k := range slices.Sorted(maps.Keys(choice)) {
+					if !slices.Contains([]string{"Assign", "Condition", "Next", "Output"}, k) {
+						errs = append(errs, fmt.Errorf("choice rule %d: %s cannot be used with the JSONata query language", i, k))
+					}
+				}
+			} else {
+				for _, k := range []string{"Condition", "Output"} {
+					if _, ok := choice[k]; ok {
+						errs = append(errs, fmt.Errorf("choice rule %d: %s cannot be used with the JSONPath query language", i, k))
+					}
+				}
+				if len(choice) < 2 {
+					errs = append(errs, fmt.Errorf("choice rule %d: a comparison must be set with the JSONPath query language", i))
+				}
+			}
+		}
+		if state.Default != "" && !isDefined(state.Default) {
+			errs = append(errs, fmt.Errorf("Default references undefined state %q", state.Default))
+		}
+	case stateMachineDefinitionStateTypeWait:
+		var n int
+		for _, ok := range []bool{state.Seconds != 0, state.SecondsPath != "", state.Timestamp != "", state.TimestampPath != ""} {
+			if ok {
+				n++
+			}
+		}
+		if n != 1 {
+			errs = append(errs, errors.New("exactly one of Seconds, SecondsPath, Timestamp or TimestampPath must be set in a Wait state"))
+		}
+	case stateMachineDefinitionStateTypeParallel:
+		if len(state.Branches) == 0 {
+			errs = append(errs, errors.New("at least one branch must be defined in a Parallel state"))
+		}
+		for i, v := range state.Branches {
+			if err := validateStateMachineDefinitionNested(v, queryLanguage); err != nil {
+				errs = append(errs, fmt.Errorf("branch %d: %w", i, err))
+			}
+		}
+	case stateMachineDefinitionStateTypeMap:
+		if state.ItemProcessor == nil {
+			errs = append(errs, errors.New("ItemProcessor must be set in a Map state"))
+		} else if err := validateStateMachineDefinitionNested(state.ItemProcessor, queryLanguage); err != nil {
+			errs = append(errs, fmt.Errorf("ItemProcessor: %w", err))
+		}
+	}
+
+	for i, retrier := range state.Retry {
+		if err := validateStateMachineDefinitionErrorEquals(retrier.ErrorEquals, i == len(state.Retry)-1); err != nil {
+			errs = append(errs, fmt.Errorf("retrier %d: %w", i, err))
+		}
+	}
+	for i, catcher := range state.Catch {
+		if err := validateStateMachineDefinitionErrorEquals(catcher.ErrorEquals, i == len(state.Catch)-1); err != nil {
+			errs = append(errs, fmt.Errorf("catcher %d: %w", i, err))
+		}
+		if !isDefined(catcher.Next) {
+			errs = append(errs, fmt.Errorf("catcher %d: Next references undefined state %q", i, catcher.Next))
+		}
+	}
+
+	return errs
+}
+
+// validateStateMachineDefinitionNested validates a Parallel branch or Map item processor.
+func validateStateMachineDefinitionNested(v interface{}, queryLanguage string) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var definition stateMachineDefinition
+	if err := json.Unmarshal(b, &definition); err != nil {
+		return fmt.Errorf("invalid definition: %w", err)
+	}
+
+	return validateStateMachineDefinition(&definition, queryLanguage, true)
+}
+
+func validateStateMachineDefinitionErrorEquals(errorEquals []string, last bool) error {
+	if len(errorEquals) == 0 {
+		return errors.New("ErrorEquals must not be empty")
+	}
+
+	if slices.Contains(errorEquals, stateMachineDefinitionErrorAll) && (len(errorEquals) != 1 || !last) {
+		return fmt.Errorf("%s must appear alone and in the last retrier or catcher", stateMachineDefinitionErrorAll)
+	}
+
+	return nil
+}
+
+// stateMachineDefinitionEffectiveQueryLanguage returns the query language that applies to a definition or state.
+// A JSONata state machine cannot contain JSONPath states.
+func stateMachineDefinitionEffectiveQueryLanguage(queryLanguage, inherited string) (string, error) {
+	if inherited == "" {
+		inherited = string(stateMachineDefinitionQueryLanguageJSONPath)
+	}
+
+	switch queryLanguage {
+	case "":
+		return inherited, nil
+	case string(stateMachineDefinitionQueryLanguageJSONata):
+		return queryLanguage, nil
+	case string(stateMachineDefinitionQueryLanguageJSONPath):
+		if inherited == string(stateMachineDefinitionQueryLanguageJSONata) {
+			return inherited, errors.New("QueryLanguage cannot be JSONPath when the enclosing query language is JSONata")
+		}
+		return queryLanguage, nil
+	default:
+		return inherited, fmt.Errorf("unsupported QueryLanguage %q", queryLanguage)
+	}
+}
+
+func stateMachineDefinitionStateIsTerminal(state *stateMachineDefinitionState) bool {
+	switch stateMachineDefinitionStateType(state.Type) {
+	case stateMachineDefinitionStateTypeFail, stateMachineDefinitionStateTypeSucceed:
+		return true
+	default:
+		return state.End
+	}
+}
+
+// stateMachineDefinitionTransitions returns the names of the states that a state can transition to.
+func stateMachineDefinitionTransitions(state *stateMachineDefinitionState) []string {
+	if state == nil {
+		return nil
+	}
+
+	var transitions []string
+
+	if state.Next != "" {
+		transitions = append(transitions, state.Next)
+	}
+	if state.Default != "" {
+		transitions = append(transitions, state.Default)
+	}
+	for _, choice := range state.Choices {
+		if next, ok := choice["Next"].(string); ok && next != "" {
+			transitions = append(transitions, next)
+		}
+	}
+	for _, catcher := range state.Catch {
+		if catcher.Next != "" {
+			transitions = append(transitions, catcher.Next)
+		}
+	}
+
+	return transitions
+}
+
+// stateMachineDefinitionReachable returns the set of states reachable from the initial states.
+func stateMachineDefinitionReachable(initial []string, edges func(string) []string) map[string]struct{} {
+	reachable := make(map[string]struct{})
+	queue := slices.Clone(initial)
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		if _, ok := reachable[name]; ok {
+			continue
+		}
+		reachable[name] = struct{}{}
+
+		queue = append(queue, edges(name)...)
+	}
+
+	return reachable
+}
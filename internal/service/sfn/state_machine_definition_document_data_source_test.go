@@ -0,0 +1,524 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsfn "github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidateStateMachineDefinition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition  string
+		expectedErr string
+	}{
+		"valid": {
+			definition: `{
+  "StartAt": "Check",
+  "States": {
+    "Check": {"Type": "Choice", "Choices": [{"Variable": "$.ok", "BooleanEquals": true, "Next": "Work"}], "Default": "Failed"},
+    "Work": {"Type": "Task", "Resource": "arn:aws:lambda:us-west-2:123456789012:function:work", "Retry": [{"ErrorEquals": ["States.ALL"]}], "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Failed"}], "Next": "Pause"},
+    "Pause": {"Type": "Wait", "Seconds": 5, "Next": "Done"},
+    "Done": {"Type": "Succeed"},
+    "Failed": {"Type": "Fail", "Error": "Failed"}
+  }
+}`,
+		},
+		"valid JSONata": {
+			definition: `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "Check",
+  "States": {
+    "Check": {"Type": "Choice", "Choices": [{"Condition": "{% $states.input.ok %}", "Next": "Done"}], "Default": "Done"},
+    "Done": {"Type": "Pass", "Output": "{% $states.input %}", "End": true}
+  }
+}`,
+		},
+		"valid nested": {
+			definition: `{
+  "StartAt": "Fan",
+  "States": {
+    "Fan": {"Type": "Parallel", "Branches": [{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}}], "Next": "Each"},
+    "Each": {"Type": "Map", "ItemProcessor": {"StartAt": "B", "States": {"B": {"Type": "Succeed"}}}, "End": true}
+  }
+}`,
+		},
+		"undefined StartAt": {
+			definition:  `{"StartAt": "Missing", "States": {"A": {"Type": "Succeed"}}}`,
+			expectedErr: `StartAt references undefined state "Missing"`,
+		},
+		"undefined Next": {
+			definition:  `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "Missing"}}}`,
+			expectedErr: `state "A": Next references undefined state "Missing"`,
+		},
+		"undefined catch Next": {
+			definition:  `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn", "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Missing"}], "End": true}}}`,
+			expectedErr: `state "A": catcher 0: Next references undefined state "Missing"`,
+		},
+		"missing Next and End": {
+			definition:  `{"StartAt": "A", "States": {"A": {"Type": "Pass"}}}`,
+			expectedErr: `state "A": one of Next or End must be set`,
+		},
+		"Next and End": {
+			definition:  `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B", "End": true}, "B": {"Type": "Succeed"}}}`,
+			expectedErr: `state "A": only one of Next or End can be set`,
+		},
+		"End in Succeed": {
+			definition:  `{"StartAt": "A", "States": {"A": {"Type": "Succeed", "End": true}}}`,
+			expectedErr: `state "A": Next and End cannot be set in a Succeed state`,
+		},
+		"unreachable": {
+			definition:  `{"StartAt": "A", "States": {"A": {"Type": "Succeed"}, "B": {"Type": "Succeed"}}}`,
+			expectedErr: `state "B" is not reachable from StartAt`,
+		},
+		"no terminal path": {
+			definition:  `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B"}, "B": {"Type": "Pass", "Next": "A"}, "C": {"Type": "Succeed"}}}`,
+			expectedErr: `state "A" cannot reach a terminal state`,
+		},
+		"Task without Resource": {
+			definition:  `{"StartAt": "A", "States": {"A": {"Type": "Task", "End": true}}}`,
+			expectedErr: `state "A": Resource must be set in a Task state`,
+		},
+		"Wait without duration": {
+			definition:  `{"StartAt": "A", "States": {"A": {"Type": "Wait", "End": true}}}`,
+			expectedErr: `exactly one of Seconds, SecondsPath, Timestamp or TimestampPath must be set`,
+		},
+		"field for other type": {
+			definition:  `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Resource": "arn", "End": true}}}`,
+			expectedErr: `state "A": Resource cannot be set in a Pass state`,
+		},
+		"JSONPath field with JSONata": {
+			definition:  `{"QueryLanguage": "JSONata", "StartAt": "A", "States": {"A": {"Type": "Pass", "InputPath": "$.a", "End": true}}}`,
+			expectedErr: `state "A": InputPath cannot be used with the JSONata query language`,
+		},
+		"JSONata field with JSONPath": {
+			definition:  `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Output": {}, "End": true}}}`,
+			expectedErr: `state "A": Output cannot be used with the JSONPath query language`,
+		},
+		"JSONPath state in JSONata machine": {
+			definition:  `{"QueryLanguage": "JSONata", "StartAt": "A", "States": {"A": {"Type": "Pass", "QueryLanguage": "JSONPath", "End": true}}}`,
+			expectedErr: `QueryLanguage cannot be JSONPath when the enclosing query language is JSONata`,
+		},
+		"JSONata choice without Condition": {
+			definition:  `{"QueryLanguage": "JSONata", "StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.a", "IsPresent": true, "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			expectedErr: `choice rule 0: Condition must be set with the JSONata query language`,
+		},
+		"States.ALL not last": {
+			definition:  `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn", "Retry": [{"ErrorEquals": ["States.ALL"]}, {"ErrorEquals": ["States.Timeout"]}], "End": true}}}`,
+			expectedErr: `retrier 0: States.ALL must appear alone and in the last retrier or catcher`,
+		},
+		"invalid branch": {
+			definition:  `{"StartAt": "A", "States": {"A": {"Type": "Parallel", "Branches": [{"StartAt": "B", "States": {"B": {"Type": "Pass"}}}], "End": true}}}`,
+			expectedErr: `state "A": branch 0: state "B": one of Next or End must be set`,
+		},
+		"nested TimeoutSeconds": {
+			definition:  `{"StartAt": "A", "States": {"A": {"Type": "Map", "ItemProcessor": {"StartAt": "B", "TimeoutSeconds": 10, "States": {"B": {"Type": "Succeed"}}}, "End": true}}}`,
+			expectedErr: `state "A": ItemProcessor: TimeoutSeconds and Version can only be set at the top level`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var definition tfsfn.StateMachineDefinition
+			if err := json.Unmarshal([]byte(testCase.definition), &definition); err != nil {
+				t.Fatalf("parsing definition: %s", err)
+			}
+
+			err := tfsfn.ValidateStateMachineDefinition(&definition, "", false)
+
+			if testCase.expectedErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", testCase.expectedErr)
+			}
+			if !strings.Contains(err.Error(), testCase.expectedErr) {
+				t.Errorf("expected error containing %q, got %q", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, `{
+  "Comment": "Process an order",
+  "StartAt": "Validate",
+  "States": {
+    "Validate": {
+      "Type": "Choice",
+      "Choices": [{"Variable": "$.valid", "BooleanEquals": true, "Next": "Process"}],
+      "Default": "Rejected"
+    },
+    "Process": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {"FunctionName": "process", "Payload.$": "$"},
+      "ResultPath": "$.result",
+      "Retry": [{"ErrorEquals": ["States.TaskFailed"], "IntervalSeconds": 2, "MaxAttempts": 5, "BackoffRate": 2}],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Rejected"}],
+      "Next": "Pause"
+    },
+    "Pause": {"Type": "Wait", "Seconds": 10, "Next": "Done"},
+    "Done": {"Type": "Succeed"},
+    "Rejected": {"Type": "Fail", "Error": "OrderRejected", "Cause": "Order is invalid"}
+  }
+}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_jsonata(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDocumentDataSourceConfig_jsonata,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "Check",
+  "States": {
+    "Check": {
+      "Type": "Choice",
+      "Choices": [{"Condition": "{% $states.input.total > 100 %}", "Next": "Large"}],
+      "Default": "Small"
+    },
+    "Large": {"Type": "Pass", "Output": {"size": "large"}, "End": true},
+    "Small": {"Type": "Pass", "Output": "{% $states.input %}", "End": true}
+  }
+}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_nested(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDocumentDataSourceConfig_nested,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, `{
+  "StartAt": "Fan",
+  "States": {
+    "Fan": {
+      "Type": "Parallel",
+      "Branches": [
+        {"StartAt": "Left", "States": {"Left": {"Type": "Pass", "End": true}}},
+        {"StartAt": "Right", "States": {"Right": {"Type": "Pass", "End": true}}}
+      ],
+      "Next": "Each"
+    },
+    "Each": {
+      "Type": "Map",
+      "ItemsPath": "$.items",
+      "MaxConcurrency": 2,
+      "ItemProcessor": {
+        "ProcessorConfig": {"Mode": "INLINE"},
+        "StartAt": "Item",
+        "States": {"Item": {"Type": "Pass", "End": true}}
+      },
+      "End": true
+    }
+  }
+}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_stateMachine(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_sfn_state_machine_definition_document.test"
+	resourceName := "aws_sfn_state_machine.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDocumentDataSourceConfig_stateMachine(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "definition", dataSourceName, names.AttrJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_undefinedNext(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineDefinitionDocumentDataSourceConfig_undefinedNext,
+				ExpectError: regexache.MustCompile(`state "First": Next references undefined state "Missing"`),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_unreachable(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineDefinitionDocumentDataSourceConfig_unreachable,
+				ExpectError: regexache.MustCompile(`state "Orphan" is not reachable from StartAt`),
+			},
+		},
+	})
+}
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_basic = `
+data "aws_sfn_state_machine_definition_document" "test" {
+  comment  = "Process an order"
+  start_at = "Validate"
+
+  state {
+    name    = "Validate"
+    type    = "Choice"
+    default = "Rejected"
+
+    choice {
+      rule = jsonencode({ Variable = "$.valid", BooleanEquals = true })
+      next = "Process"
+    }
+  }
+
+  state {
+    name        = "Process"
+    type        = "Task"
+    resource    = "arn:aws:states:::lambda:invoke"
+    parameters  = jsonencode({ FunctionName = "process", "Payload.$" = "$" })
+    result_path = "$.result"
+    next        = "Pause"
+
+    retry {
+      error_equals     = ["States.TaskFailed"]
+      interval_seconds = 2
+      max_attempts     = 5
+      backoff_rate     = 2
+    }
+
+    catch {
+      error_equals = ["States.ALL"]
+      next         = "Rejected"
+    }
+  }
+
+  state {
+    name    = "Pause"
+    type    = "Wait"
+    seconds = 10
+    next    = "Done"
+  }
+
+  state {
+    name = "Done"
+    type = "Succeed"
+  }
+
+  state {
+    name  = "Rejected"
+    type  = "Fail"
+    error = "OrderRejected"
+    cause = "Order is invalid"
+  }
+}
+`
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_jsonata = `
+data "aws_sfn_state_machine_definition_document" "test" {
+  query_language = "JSONata"
+  start_at       = "Check"
+
+  state {
+    name    = "Check"
+    type    = "Choice"
+    default = "Small"
+
+    choice {
+      condition = "{% $states.input.total > 100 %}"
+      next      = "Large"
+    }
+  }
+
+  state {
+    name   = "Large"
+    type   = "Pass"
+    output = jsonencode({ size = "large" })
+    end    = true
+  }
+
+  state {
+    name   = "Small"
+    type   = "Pass"
+    output = jsonencode("{% $states.input %}")
+    end    = true
+  }
+}
+`
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_nested = `
+data "aws_sfn_state_machine_definition_document" "left" {
+  start_at = "Left"
+
+  state {
+    name = "Left"
+    type = "Pass"
+    end  = true
+  }
+}
+
+data "aws_sfn_state_machine_definition_document" "right" {
+  start_at = "Right"
+
+  state {
+    name = "Right"
+    type = "Pass"
+    end  = true
+  }
+}
+
+data "aws_sfn_state_machine_definition_document" "item" {
+  start_at = "Item"
+
+  state {
+    name = "Item"
+    type = "Pass"
+    end  = true
+  }
+}
+
+data "aws_sfn_state_machine_definition_document" "test" {
+  start_at = "Fan"
+
+  state {
+    name = "Fan"
+    type = "Parallel"
+    next = "Each"
+
+    branches = [
+      data.aws_sfn_state_machine_definition_document.left.json,
+      data.aws_sfn_state_machine_definition_document.right.json,
+    ]
+  }
+
+  state {
+    name            = "Each"
+    type            = "Map"
+    items_path      = "$.items"
+    max_concurrency = 2
+    end             = true
+
+    item_processor {
+      definition = data.aws_sfn_state_machine_definition_document.item.json
+    }
+  }
+}
+`
+
+func testAccStateMachineDefinitionDocumentDataSourceConfig_stateMachine(rName string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
+data "aws_sfn_state_machine_definition_document" "test" {
+  start_at = "Wait"
+
+  state {
+    name    = "Wait"
+    type    = "Wait"
+    seconds = 1
+    end     = true
+  }
+}
+
+resource "aws_sfn_state_machine" "test" {
+  name       = %[1]q
+  role_arn   = aws_iam_role.for_sfn.arn
+  definition = data.aws_sfn_state_machine_definition_document.test.json
+}
+`, rName))
+}
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_undefinedNext = `
+data "aws_sfn_state_machine_definition_document" "test" {
+  start_at = "First"
+
+  state {
+    name = "First"
+    type = "Pass"
+    next = "Missing"
+  }
+}
+`
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_unreachable = `
+data "aws_sfn_state_machine_definition_document" "test" {
+  start_at = "First"
+
+  state {
+    name = "First"
+    type = "Succeed"
+  }
+
+  state {
+    name = "Orphan"
+    type = "Succeed"
+  }
+}
+`
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+type stateMachineDefinitionQueryLanguage string
+
+const (
+	stateMachineDefinitionQueryLanguageJSONata  stateMachineDefinitionQueryLanguage = "JSONata"
+	stateMachineDefinitionQueryLanguageJSONPath stateMachineDefinitionQueryLanguage = "JSONPath"
+)
+
+func (stateMachineDefinitionQueryLanguage) Values() []stateMachineDefinitionQueryLanguage {
+	return []stateMachineDefinitionQueryLanguage{
+		stateMachineDefinitionQueryLanguageJSONata,
+		stateMachineDefinitionQueryLanguageJSONPath,
+	}
+}
+
+type stateMachineDefinitionStateType string
+
+const (
+	stateMachineDefinitionStateTypeChoice   stateMachineDefinitionStateType = "Choice"
+	stateMachineDefinitionStateTypeFail     stateMachineDefinitionStateType = "Fail"
+	stateMachineDefinitionStateTypeMap      stateMachineDefinitionStateType = "Map"
+	stateMachineDefinitionStateTypeParallel stateMachineDefinitionStateType = "Parallel"
+	stateMachineDefinitionStateTypePass     stateMachineDefinitionStateType = "Pass"
+	stateMachineDefinitionStateTypeSucceed  stateMachineDefinitionStateType = "Succeed"
+	stateMachineDefinitionStateTypeTask     stateMachineDefinitionStateType = "Task"
+	stateMachineDefinitionStateTypeWait     stateMachineDefinitionStateType = "Wait"
+)
+
+func (stateMachineDefinitionStateType) Values() []stateMachineDefinitionStateType {
+	return []stateMachineDefinitionStateType{
+		stateMachineDefinitionStateTypeChoice,
+		stateMachineDefinitionStateTypeFail,
+		stateMachineDefinitionStateTypeMap,
+		stateMachineDefinitionStateTypeParallel,
+		stateMachineDefinitionStateTypePass,
+		stateMachineDefinitionStateTypeSucceed,
+		stateMachineDefinitionStateTypeTask,
+		stateMachineDefinitionStateTypeWait,
+	}
+}
+
+type stateMachineDefinitionProcessorMode string
+
+const (
+	stateMachineDefinitionProcessorModeDistributed stateMachineDefinitionProcessorMode = "DISTRIBUTED"
+	stateMachineDefinitionProcessorModeInline      stateMachineDefinitionProcessorMode = "INLINE"
+)
+
+func (stateMachineDefinitionProcessorMode) Values() []stateMachineDefinitionProcessorMode {
+	return []stateMachineDefinitionProcessorMode{
+		stateMachineDefinitionProcessorModeDistributed,
+		stateMachineDefinitionProcessorModeInline,
+	}
+}
+
+type stateMachineDefinitionExecutionType string
+
+const (
+	stateMachineDefinitionExecutionTypeExpress  stateMachineDefinitionExecutionType = "EXPRESS"
+	stateMachineDefinitionExecutionTypeStandard stateMachineDefinitionExecutionType = "STANDARD"
+)
+
+func (stateMachineDefinitionExecutionType) Values() []stateMachineDefinitionExecutionType {
+	return []stateMachineDefinitionExecutionType{
+		stateMachineDefinitionExecutionTypeExpress,
+		stateMachineDefinitionExecutionTypeStandard,
+	}
+}
+
+type stateMachineDefinitionJitterStrategy string
+
+const (
+	stateMachineDefinitionJitterStrategyFull stateMachineDefinitionJitterStrategy = "FULL"
+	stateMachineDefinitionJitterStrategyNone stateMachineDefinitionJitterStrategy = "NONE"
+)
+
+func (stateMachineDefinitionJitterStrategy) Values() []stateMachineDefinitionJitterStrategy {
+	return []stateMachineDefinitionJitterStrategy{
+		stateMachineDefinitionJitterStrategyFull,
+		stateMachineDefinitionJitterStrategyNone,
+	}
+}
+
+// stateMachineDefinition is an Amazon States Language state machine, Parallel branch or Map item processor.
+// See https://states-language.net/spec.html.
+type stateMachineDefinition struct {
+	Comment        string                                  `json:",omitempty"`
+	QueryLanguage  string                                  `json:",omitempty"`
+	StartAt        string                                  `json:",omitempty"`
+	States         map[string]*stateMachineDefinitionState `json:",omitempty"`
+	TimeoutSeconds int                                     `json:",omitempty"`
+	Version        string                                  `json:",omitempty"`
+}
+
+type stateMachineDefinitionState struct {
+	Type          string `json:",omitempty"`
+	Comment       string `json:",omitempty"`
+	QueryLanguage string `json:",omitempty"`
+	Next          string `json:",omitempty"`
+	End           bool   `json:",omitempty"`
+
+	// JSONPath input and output processing.
+	InputPath      string      `json:",omitempty"`
+	OutputPath     string      `json:",omitempty"`
+	Parameters     interface{} `json:",omitempty"`
+	ResultPath     string      `json:",omitempty"`
+	ResultSelector interface{} `json:",omitempty"`
+
+	// JSONata input and output processing.
+	Arguments interface{} `json:",omitempty"`
+	Output    interface{} `json:",omitempty"`
+
+	Assign interface{} `json:",omitempty"`
+
+	// Task.
+	Resource         string                           `json:",omitempty"`
+	TimeoutSeconds   int                              `json:",omitempty"`
+	HeartbeatSeconds int                              `json:",omitempty"`
+	Retry            []*stateMachineDefinitionRetrier `json:",omitempty"`
+	Catch            []*stateMachineDefinitionCatcher `json:",omitempty"`
+
+	// Choice.
+	Choices []map[string]interface{} `json:",omitempty"`
+	Default string                   `json:",omitempty"`
+
+	// Wait.
+	Seconds       int    `json:",omitempty"`
+	SecondsPath   string `json:",omitempty"`
+	Timestamp     string `json:",omitempty"`
+	TimestampPath string `json:",omitempty"`
+
+	// Pass.
+	Result interface{} `json:",omitempty"`
+
+	// Fail.
+	Cause     string `json:",omitempty"`
+	CausePath string `json:",omitempty"`
+	Error     string `json:",omitempty"`
+	ErrorPath string `json:",omitempty"`
+
+	// Parallel.
+	Branches []interface{} `json:",omitempty"`
+
+	// Map.
+	ItemProcessor  map[string]interface{} `json:",omitempty"`
+	ItemReader     interface{}            `json:",omitempty"`
+	ItemSelector   interface{}            `json:",omitempty"`
+	Items          interface{}            `json:",omitempty"`
+	ItemsPath      string                 `json:",omitempty"`
+	MaxConcurrency int                    `json:",omitempty"`
+	ResultWriter   interface{}            `json:",omitempty"`
+}
+
+type stateMachineDefinitionRetrier struct {
+	ErrorEquals     []string `json:",omitempty"`
+	IntervalSeconds int      `json:",omitempty"`
+	MaxAttempts     *int     `json:",omitempty"`
+	BackoffRate     float64  `json:",omitempty"`
+	MaxDelaySeconds int      `json:",omitempty"`
+	JitterStrategy  string   `json:",omitempty"`
+}
+
+type stateMachineDefinitionCatcher struct {
+	ErrorEquals []string    `json:",omitempty"`
+	Next        string      `json:",omitempty"`
+	ResultPath  string      `json:",omitempty"`
+	Output      interface{} `json:",omitempty"`
+	Assign      interface{} `json:",omitempty"`
+}
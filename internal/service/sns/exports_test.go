@@ -15,6 +15,7 @@ var (
 	FindSubscriptionAttributesByARN                = findSubscriptionAttributesByARN
 	FindTopicAttributesByARN                       = findTopicAttributesByARN
 	FindTopicAttributesWithValidAWSPrincipalsByARN = findTopicAttributesWithValidAWSPrincipalsByARN // nosemgrep:ci.aws-in-var-name
+	FindTopicSubscriptionARNsByTopicARN            = findTopicSubscriptionARNsByTopicARN
 
 	FIFOTopicNameSuffix                = fifoTopicNameSuffix
 	ParsePlatformApplicationResourceID = parsePlatformApplicationResourceID
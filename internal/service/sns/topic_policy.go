@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,11 +18,18 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// topicPolicyValidation validates topic policies with IAM Access Analyzer.
+var topicPolicyValidation = &tfaccessanalyzer.PolicyValidation{
+	Attribute:  names.AttrPolicy,
+	PolicyType: accessanalyzertypes.PolicyTypeResourcePolicy,
+}
+
 // @SDKResource("aws_sns_topic_policy", name="Topic Policy")
 func resourceTopicPolicy() *schema.Resource {
 	return &schema.Resource{
@@ -57,6 +65,8 @@ func resourceTopicPolicy() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: topicPolicyValidation.CustomizeDiff,
 	}
 }
 
@@ -80,6 +90,8 @@ func resourceTopicPolicyUpsert(ctx context.Context, d *schema.ResourceData, meta
 		d.SetId(arn)
 	}
 
+	diags = append(diags, topicPolicyValidation.Warnings(ctx, d, meta)...)
+
 	return append(diags, resourceTopicPolicyRead(ctx, d, meta)...)
 }
 
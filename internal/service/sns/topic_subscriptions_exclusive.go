@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_sns_topic_subscriptions_exclusive", name="Topic Subscriptions Exclusive")
+func newResourceTopicSubscriptionsExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceTopicSubscriptionsExclusive{}, nil
+}
+
+const (
+	ResNameTopicSubscriptionsExclusive = "Topic Subscriptions Exclusive"
+)
+
+// Placeholder subscription ARNs returned by ListSubscriptionsByTopic.
+const (
+	subscriptionARNDeleted             = "Deleted"
+	subscriptionARNPendingConfirmation = "PendingConfirmation"
+)
+
+type resourceTopicSubscriptionsExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *resourceTopicSubscriptionsExclusive) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_sns_topic_subscriptions_exclusive"
+}
+
+func (r *resourceTopicSubscriptionsExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"subscription_arns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			names.AttrTopicARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceTopicSubscriptionsExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceTopicSubscriptionsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var subscriptionARNs []string
+	resp.Diagnostics.Append(plan.SubscriptionARNs.ElementsAs(ctx, &subscriptionARNs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncSubscriptions(ctx, plan.TopicARN.ValueString(), subscriptionARNs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SNS, create.ErrActionCreating, ResNameTopicSubscriptionsExclusive, plan.TopicARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceTopicSubscriptionsExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().SNSClient(ctx)
+
+	var state resourceTopicSubscriptionsExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findTopicSubscriptionARNsByTopicARN(ctx, conn, state.TopicARN.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SNS, create.ErrActionReading, ResNameTopicSubscriptionsExclusive, state.TopicARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.SubscriptionARNs = flex.FlattenFrameworkStringValueSetLegacy(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceTopicSubscriptionsExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceTopicSubscriptionsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.SubscriptionARNs.Equal(state.SubscriptionARNs) {
+		var subscriptionARNs []string
+		resp.Diagnostics.Append(plan.SubscriptionARNs.ElementsAs(ctx, &subscriptionARNs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncSubscriptions(ctx, plan.TopicARN.ValueString(), subscriptionARNs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SNS, create.ErrActionUpdating, ResNameTopicSubscriptionsExclusive, plan.TopicARN.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncSubscriptions handles keeping the configured topic subscriptions
+// in sync with the remote resource.
+//
+// Confirmed subscriptions to the topic but not configured on this resource
+// will be unsubscribed. Subscriptions are created by other resources, so configured
+// subscriptions missing from the topic are an error.
+func (r *resourceTopicSubscriptionsExclusive) syncSubscriptions(ctx context.Context, topicARN string, want []string) error {
+	conn := r.Meta().SNSClient(ctx)
+
+	have, err := findTopicSubscriptionARNsByTopicARN(ctx, conn, topicARN)
+	if err != nil {
+		return err
+	}
+
+	members := framework.ExclusiveMembers[string]{
+		Have: have,
+		Want: want,
+		Remove: func(ctx context.Context, arn string) error {
+			_, err := conn.Unsubscribe(ctx, &sns.UnsubscribeInput{
+				SubscriptionArn: aws.String(arn),
+			})
+
+			if errs.IsA[*awstypes.NotFoundException](err) {
+				return nil
+			}
+
+			if err != nil {
+				return err
+			}
+
+			_, err = waitSubscriptionDeleted(ctx, conn, arn, subscriptionDeleteTimeout)
+
+			return err
+		},
+	}
+
+	return members.Sync(ctx)
+}
+
+func (r *resourceTopicSubscriptionsExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrTopicARN), req, resp)
+}
+
+// findTopicSubscriptionARNsByTopicARN returns the ARNs of the confirmed subscriptions to a topic.
+// Subscriptions pending confirmation have no ARN and cannot be unsubscribed, so they are not returned.
+func findTopicSubscriptionARNsByTopicARN(ctx context.Context, conn *sns.Client, arn string) ([]string, error) {
+	input := &sns.ListSubscriptionsByTopicInput{
+		TopicArn: aws.String(arn),
+	}
+
+	var subscriptionARNs []string
+	pages := sns.NewListSubscriptionsByTopicPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Subscriptions {
+			switch v := aws.ToString(v.SubscriptionArn); v {
+			case "", subscriptionARNDeleted, subscriptionARNPendingConfirmation:
+			default:
+				subscriptionARNs = append(subscriptionARNs, v)
+			}
+		}
+	}
+
+	return subscriptionARNs, nil
+}
+
+type resourceTopicSubscriptionsExclusiveData struct {
+	SubscriptionARNs types.Set   `tfsdk:"subscription_arns"`
+	TopicARN         fwtypes.ARN `tfsdk:"topic_arn"`
+}
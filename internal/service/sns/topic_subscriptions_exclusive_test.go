@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSNSTopicSubscriptionsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sns_topic_subscriptions_exclusive.test"
+	topicResourceName := "aws_sns_topic.test"
+	subscriptionResourceName := "aws_sns_topic_subscription.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicSubscriptionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicSubscriptionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTopicARN, topicResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "subscription_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "subscription_arns.*", subscriptionResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrTopicARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrTopicARN,
+			},
+		},
+	})
+}
+
+// A subscription added out of band should be unsubscribed
+func TestAccSNSTopicSubscriptionsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sns_topic_subscriptions_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicSubscriptionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicSubscriptionsExclusiveExists(ctx, resourceName),
+					testAccCheckTopicSubscriptionsExclusiveSubscribe(ctx, "aws_sns_topic.test", "aws_sqs_queue.out_of_band"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTopicSubscriptionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicSubscriptionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subscription_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTopicSubscriptionsExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return create.Error(names.SNS, create.ErrActionCheckingExistence, tfsns.ResNameTopicSubscriptionsExclusive, n, errors.New("not found"))
+		}
+
+		topicARN := rs.Primary.Attributes[names.AttrTopicARN]
+		if topicARN == "" {
+			return create.Error(names.SNS, create.ErrActionCheckingExistence, tfsns.ResNameTopicSubscriptionsExclusive, n, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSClient(ctx)
+		out, err := tfsns.FindTopicSubscriptionARNsByTopicARN(ctx, conn, topicARN)
+		if err != nil {
+			return create.Error(names.SNS, create.ErrActionCheckingExistence, tfsns.ResNameTopicSubscriptionsExclusive, topicARN, err)
+		}
+
+		if rs.Primary.Attributes["subscription_arns.#"] != strconv.Itoa(len(out)) {
+			return create.Error(names.SNS, create.ErrActionCheckingExistence, tfsns.ResNameTopicSubscriptionsExclusive, topicARN, errors.New("unexpected subscription_arns count"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTopicSubscriptionsExclusiveSubscribe(ctx context.Context, topicName, queueName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		topic, ok := s.RootModule().Resources[topicName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", topicName)
+		}
+		queue, ok := s.RootModule().Resources[queueName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", queueName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSClient(ctx)
+
+		_, err := conn.Subscribe(ctx, &sns.SubscribeInput{
+			Endpoint:              aws.String(queue.Primary.Attributes[names.AttrARN]),
+			Protocol:              aws.String("sqs"),
+			ReturnSubscriptionArn: true,
+			TopicArn:              aws.String(topic.Primary.Attributes[names.AttrARN]),
+		})
+
+		return err
+	}
+}
+
+func testAccTopicSubscriptionsExclusiveConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "out_of_band" {
+  name = "%[1]s-out-of-band"
+}
+
+resource "aws_sns_topic_subscription" "test" {
+  topic_arn = aws_sns_topic.test.arn
+  protocol  = "sqs"
+  endpoint  = aws_sqs_queue.test.arn
+}
+
+resource "aws_sns_topic_subscriptions_exclusive" "test" {
+  topic_arn         = aws_sns_topic.test.arn
+  subscription_arns = [aws_sns_topic_subscription.test.arn]
+}
+`, rName)
+}
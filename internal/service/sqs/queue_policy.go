@@ -4,14 +4,25 @@
 package sqs
 
 import (
+	"context"
+
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// queuePolicyValidation validates queue policies with IAM Access Analyzer.
+var queuePolicyValidation = &tfaccessanalyzer.PolicyValidation{
+	Attribute:  names.AttrPolicy,
+	PolicyType: accessanalyzertypes.PolicyTypeResourcePolicy,
+}
+
 // @SDKResource("aws_sqs_queue_policy", name="Queue Policy")
 func resourceQueuePolicy() *schema.Resource {
 	h := &queueAttributeHandler{
@@ -20,11 +31,20 @@ func resourceQueuePolicy() *schema.Resource {
 		ToSet:         verify.PolicyToSet,
 	}
 
+	upsert := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := h.Upsert(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+
+		return append(diags, queuePolicyValidation.Warnings(ctx, d, meta)...)
+	}
+
 	//lintignore:R011
 	return &schema.Resource{
-		CreateWithoutTimeout: h.Upsert,
+		CreateWithoutTimeout: upsert,
 		ReadWithoutTimeout:   h.Read,
-		UpdateWithoutTimeout: h.Upsert,
+		UpdateWithoutTimeout: upsert,
 		DeleteWithoutTimeout: h.Delete,
 
 		Importer: &schema.ResourceImporter{
@@ -52,5 +72,7 @@ func resourceQueuePolicy() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: queuePolicyValidation.CustomizeDiff,
 	}
 }
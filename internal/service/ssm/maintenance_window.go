@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -69,8 +70,9 @@ func resourceMaintenanceWindow() *schema.Resource {
 				Required: true,
 			},
 			names.AttrSchedule: {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidScheduleExpression(schedule.KindAt, schedule.KindCron, schedule.KindRate),
 			},
 			"schedule_offset": {
 				Type:         schema.TypeInt,
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go-v2/service/ssooidc"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	attrSSOSession = "sso_session"
+	attrStartURL   = "start_url"
+)
+
+// cachedAccessToken returns the IAM Identity Center access token cached by `aws sso login`
+// for the specified SSO session or, for legacy profiles, start URL.
+// Tokens cached for an SSO session are refreshed if they have expired.
+func cachedAccessToken(ctx context.Context, c *conns.AWSClient, ssoSession, startURL string) (string, error) {
+	key := startURL
+	if ssoSession != "" {
+		key = ssoSession
+	}
+
+	cachedTokenFilepath, err := ssocreds.StandardCachedTokenFilepath(key)
+	if err != nil {
+		return "", err
+	}
+
+	// The provider has no SSO OIDC service package, so the client used to refresh tokens is built from the
+	// provider's AWS configuration: it uses the provider region but not the provider's endpoint overrides.
+	provider := ssocreds.NewSSOTokenProvider(ssooidc.NewFromConfig(c.AwsConfig(ctx)), cachedTokenFilepath)
+	token, err := provider.RetrieveBearerToken(ctx)
+	if err != nil {
+		return "", fmt.Errorf("retrieving cached SSO access token (%s), run `aws sso login` to refresh it: %w", key, err)
+	}
+
+	return token.Value, nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sso"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sso/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_sso_account_roles", name="Account Roles")
+func newAccountRolesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &accountRolesDataSource{}, nil
+}
+
+type accountRolesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*accountRolesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_sso_account_roles"
+}
+
+func (d *accountRolesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"roles": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[roleInfoModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[roleInfoModel](ctx),
+			},
+			attrSSOSession: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(attrStartURL)),
+				},
+			},
+			attrStartURL: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *accountRolesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data accountRolesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	accountID := data.AccountID.ValueString()
+	accessToken, err := cachedAccessToken(ctx, d.Meta(), data.SSOSession.ValueString(), data.StartURL.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Account (%s) Roles", accountID), err.Error())
+
+		return
+	}
+
+	conn := d.Meta().SSOClient(ctx)
+
+	roles, err := findAccountRoles(ctx, conn, &sso.ListAccountRolesInput{
+		AccessToken: aws.String(accessToken),
+		AccountId:   aws.String(accountID),
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Account (%s) Roles", accountID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, roles, &data.Roles)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(accountID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findAccountRoles(ctx context.Context, conn *sso.Client, input *sso.ListAccountRolesInput) ([]awstypes.RoleInfo, error) {
+	var output []awstypes.RoleInfo
+
+	pages := sso.NewListAccountRolesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RoleList...)
+	}
+
+	return output, nil
+}
+
+type accountRolesDataSourceModel struct {
+	AccountID  types.String                                   `tfsdk:"account_id"`
+	ID         types.String                                   `tfsdk:"id"`
+	Roles      fwtypes.ListNestedObjectValueOf[roleInfoModel] `tfsdk:"roles"`
+	SSOSession types.String                                   `tfsdk:"sso_session"`
+	StartURL   types.String                                   `tfsdk:"start_url"`
+}
+
+type roleInfoModel struct {
+	AccountID types.String `tfsdk:"account_id"`
+	RoleName  types.String `tfsdk:"role_name"`
+}
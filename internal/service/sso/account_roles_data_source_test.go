@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSOAccountRolesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	ssoSession := acctest.SkipIfEnvVarNotSet(t, envVarSSOSession)
+	accountID := acctest.SkipIfEnvVarNotSet(t, envVarSSOAccountID)
+	dataSourceName := "data.aws_sso_account_roles.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountRolesDataSourceConfig_basic(ssoSession, accountID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrAccountID, accountID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "roles.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "roles.0.account_id", accountID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "roles.0.role_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountRolesDataSourceConfig_basic(ssoSession, accountID string) string {
+	return fmt.Sprintf(`
+data "aws_sso_account_roles" "test" {
+  account_id  = %[2]q
+  sso_session = %[1]q
+}
+`, ssoSession, accountID)
+}
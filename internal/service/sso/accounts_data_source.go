@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sso"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sso/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_sso_accounts", name="Accounts")
+func newAccountsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &accountsDataSource{}, nil
+}
+
+type accountsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*accountsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_sso_accounts"
+}
+
+func (d *accountsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"accounts": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[accountInfoModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[accountInfoModel](ctx),
+			},
+			names.AttrID: framework.IDAttribute(),
+			attrSSOSession: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(attrStartURL)),
+				},
+			},
+			attrStartURL: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *accountsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data accountsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	accessToken, err := cachedAccessToken(ctx, d.Meta(), data.SSOSession.ValueString(), data.StartURL.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("reading SSO Accounts", err.Error())
+
+		return
+	}
+
+	conn := d.Meta().SSOClient(ctx)
+
+	accounts, err := findAccounts(ctx, conn, &sso.ListAccountsInput{
+		AccessToken: aws.String(accessToken),
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError("reading SSO Accounts", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, accounts, &data.Accounts)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.sourceKey())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findAccounts(ctx context.Context, conn *sso.Client, input *sso.ListAccountsInput) ([]awstypes.AccountInfo, error) {
+	var output []awstypes.AccountInfo
+
+	pages := sso.NewListAccountsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AccountList...)
+	}
+
+	return output, nil
+}
+
+type accountsDataSourceModel struct {
+	Accounts   fwtypes.ListNestedObjectValueOf[accountInfoModel] `tfsdk:"accounts"`
+	ID         types.String                                      `tfsdk:"id"`
+	SSOSession types.String                                      `tfsdk:"sso_session"`
+	StartURL   types.String                                      `tfsdk:"start_url"`
+}
+
+func (m accountsDataSourceModel) sourceKey() string {
+	if !m.SSOSession.IsNull() {
+		return m.SSOSession.ValueString()
+	}
+
+	return m.StartURL.ValueString()
+}
+
+type accountInfoModel struct {
+	AccountID    types.String `tfsdk:"account_id"`
+	AccountName  types.String `tfsdk:"account_name"`
+	EmailAddress types.String `tfsdk:"email_address"`
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// envVarSSOSession is the name of an SSO session with an access token cached by `aws sso login`.
+	envVarSSOSession = "TF_AWS_SSO_SESSION"
+	// envVarSSOAccountID is the ID of an account assigned to the signed-in user.
+	envVarSSOAccountID = "TF_AWS_SSO_ACCOUNT_ID"
+	// envVarSSORoleName is the name of a role assigned to the signed-in user in that account.
+	envVarSSORoleName = "TF_AWS_SSO_ROLE_NAME"
+)
+
+func TestAccSSOAccountsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	ssoSession := acctest.SkipIfEnvVarNotSet(t, envVarSSOSession)
+	dataSourceName := "data.aws_sso_accounts.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountsDataSourceConfig_basic(ssoSession),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "accounts.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "accounts.0.account_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "accounts.0.account_name"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrID, ssoSession),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountsDataSourceConfig_basic(ssoSession string) string {
+	return fmt.Sprintf(`
+data "aws_sso_accounts" "test" {
+  sso_session = %[1]q
+}
+`, ssoSession)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sso"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_sso_role_credentials", name="Role Credentials")
+func newRoleCredentialsEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &roleCredentialsEphemeralResource{}, nil
+}
+
+type roleCredentialsEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (*roleCredentialsEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_sso_role_credentials"
+}
+
+func (e *roleCredentialsEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrAccountID: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"role_name": schema.StringAttribute{
+				Required: true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			attrSSOSession: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(attrStartURL)),
+				},
+			},
+			attrStartURL: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (e *roleCredentialsEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data roleCredentialsEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	accountID, roleName := data.AccountID.ValueString(), data.RoleName.ValueString()
+	accessToken, err := cachedAccessToken(ctx, e.Meta(), data.SSOSession.ValueString(), data.StartURL.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Role (%s/%s) Credentials", accountID, roleName), err.Error())
+
+		return
+	}
+
+	conn := e.Meta().SSOClient(ctx)
+
+	input := &sso.GetRoleCredentialsInput{
+		AccessToken: aws.String(accessToken),
+		AccountId:   aws.String(accountID),
+		RoleName:    aws.String(roleName),
+	}
+
+	output, err := conn.GetRoleCredentials(ctx, input)
+
+	if err == nil && (output == nil || output.RoleCredentials == nil) {
+		err = tfresource.NewEmptyResultError(input)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Role (%s/%s) Credentials", accountID, roleName), err.Error())
+
+		return
+	}
+
+	credentials := output.RoleCredentials
+	data.AccessKeyID = fwflex.StringToFramework(ctx, credentials.AccessKeyId)
+	data.Expiration = timetypes.NewRFC3339TimeValue(time.UnixMilli(credentials.Expiration).UTC())
+	data.SecretAccessKey = fwflex.StringToFramework(ctx, credentials.SecretAccessKey)
+	data.SessionToken = fwflex.StringToFramework(ctx, credentials.SessionToken)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type roleCredentialsEphemeralResourceModel struct {
+	AccessKeyID     types.String      `tfsdk:"access_key_id"`
+	AccountID       types.String      `tfsdk:"account_id"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	RoleName        types.String      `tfsdk:"role_name"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	SessionToken    types.String      `tfsdk:"session_token"`
+	SSOSession      types.String      `tfsdk:"sso_session"`
+	StartURL        types.String      `tfsdk:"start_url"`
+}
@@ -14,8 +14,29 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.ServicePackageEphemeralResource {
+	return []*types.ServicePackageEphemeralResource{
+		{
+			Factory:  newRoleCredentialsEphemeralResource,
+			TypeName: "aws_sso_role_credentials",
+			Name:     "Role Credentials",
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newAccountRolesDataSource,
+			TypeName: "aws_sso_account_roles",
+			Name:     "Account Roles",
+		},
+		{
+			Factory:  newAccountsDataSource,
+			TypeName: "aws_sso_accounts",
+			Name:     "Accounts",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -186,7 +186,7 @@ func resourceSMBFileShare() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"smb_acl_enabled": {
 				Type:     schema.TypeBool,
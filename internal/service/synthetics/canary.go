@@ -96,7 +96,7 @@ func ResourceCanary() *schema.Resource {
 			names.AttrExecutionRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"failure_retention_period": {
 				Type:         schema.TypeInt,
@@ -59,7 +59,7 @@ func resourceDatabase() *schema.Resource {
 				// The ARN is of the format 'arn:aws:kms:REGION:ACCOUNT_ID:key/KMS_KEY_ID'. Appropriate diff suppression
 				// would require an extra API call to the kms service's DescribeKey method to decipher aliases.
 				// To avoid importing an extra service in this resource, input here is restricted to only ARNs.
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			"table_count": {
 				Type:     schema.TypeInt,
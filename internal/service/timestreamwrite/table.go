@@ -90,7 +90,7 @@ func resourceTable() *schema.Resource {
 												names.AttrKMSKeyID: {
 													Type:         schema.TypeString,
 													Optional:     true,
-													ValidateFunc: verify.ValidARN,
+													ValidateFunc: verify.ValidKMSKeyARN,
 												},
 												"object_key_prefix": {
 													Type:     schema.TypeString,
@@ -5,6 +5,7 @@ package verifiedpermissions
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
@@ -28,7 +29,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	tfcedar "github.com/hashicorp/terraform-provider-aws/internal/types/cedar"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -95,6 +98,9 @@ func (r *resourcePolicy) Schema(ctx context.Context, req resource.SchemaRequest,
 									},
 									"statement": schema.StringAttribute{
 										Required: true,
+										Validators: []validator.String{
+											fwvalidators.CedarPolicy(),
+										},
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.RequiresReplaceIf(
 												statementReplaceIf, "Replace cedar statement diff", "Replace cedar statement diff",
@@ -508,7 +514,16 @@ func (r *resourcePolicy) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *resourcePolicy) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	if !req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.validateStatement(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
 		var plan, state resourcePolicyData
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -539,6 +554,105 @@ func (r *resourcePolicy) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 	}
 }
 
+// validateStatement checks a new or changed static policy statement against the policy store's schema.
+// Only policy stores with strict validation are checked. The schema currently stored in the policy store is used,
+// so mismatches are reported as warnings: the schema may be updated in the same apply.
+func (r *resourcePolicy) validateStatement(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan resourcePolicyData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statement := staticStatement(ctx, plan)
+	if statement.IsNull() || statement.IsUnknown() || plan.PolicyStoreID.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state resourcePolicyData
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if statement.Equal(staticStatement(ctx, state)) {
+			return
+		}
+	}
+
+	policies, err := tfcedar.Parse(statement.ValueString())
+	if err != nil {
+		// Syntax errors are reported by the attribute validator.
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	policyStoreID := plan.PolicyStoreID.ValueString()
+
+	policyStore, err := findPolicyStoreByID(ctx, conn, policyStoreID)
+	if tfresource.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to validate policy statement",
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicyStore, policyStoreID, err),
+		)
+		return
+	}
+
+	if v := policyStore.ValidationSettings; v == nil || v.Mode != awstypes.ValidationModeStrict {
+		return
+	}
+
+	out, err := findSchemaByPolicyStoreID(ctx, conn, policyStoreID)
+	if tfresource.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to validate policy statement",
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicyStoreSchema, policyStoreID, err),
+		)
+		return
+	}
+
+	schema, err := tfcedar.ParseSchema(aws.ToString(out.Schema))
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to validate policy statement", err.Error())
+		return
+	}
+
+	if err := schema.Validate(policies); err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("definition").AtListIndex(0).AtName("static").AtListIndex(0).AtName("statement"),
+			"Policy statement does not match policy store schema",
+			fmt.Sprintf("The statement was checked against the schema currently stored in policy store %s. "+
+				"Creating or updating the policy will fail unless the schema is updated first.\n\n%s", policyStoreID, err),
+		)
+	}
+}
+
+// staticStatement returns the statement of a static policy definition, or null.
+func staticStatement(ctx context.Context, data resourcePolicyData) types.String {
+	if data.Definition.IsNull() || data.Definition.IsUnknown() {
+		return types.StringNull()
+	}
+
+	def, diags := data.Definition.ToPtr(ctx)
+	if diags.HasError() || def == nil || def.Static.IsNull() || def.Static.IsUnknown() {
+		return types.StringNull()
+	}
+
+	static, diags := def.Static.ToPtr(ctx)
+	if diags.HasError() || static == nil {
+		return types.StringNull()
+	}
+
+	return static.Statement
+}
+
 func findPolicyByID(ctx context.Context, conn *verifiedpermissions.Client, id, policyStoreId string) (*verifiedpermissions.GetPolicyOutput, error) {
 	in := &verifiedpermissions.GetPolicyInput{
 		PolicyId:      aws.String(id),
@@ -6,16 +6,21 @@ package tfresource
 import (
 	"context"
 	"errors"
-	"fmt"
 	"math/rand"
 	"sync"
 	"time"
 
-	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfretry "github.com/hashicorp/terraform-provider-aws/internal/retry"
 )
 
+// The Retry* helpers in this file are a compatibility layer over the internal/retry package.
+// New code should use tfretry.Operation directly.
+
+// compatibleBackoff matches the delays between refreshes of retry.StateChangeConf when used by Retry.
+var compatibleBackoff = tfretry.ExponentialBackoff(500*time.Millisecond, 2, 10*time.Second)
+
 // Retryable is a function that is used to decide if a function's error is retryable or not.
 // The error argument can be `nil`.
 // If the error is retryable, returns a bool value of `true` and an error (not necessarily the error passed as the argument).
@@ -25,66 +30,34 @@ type Retryable func(error) (bool, error)
 // RetryWhen retries the function `f` when the error it returns satisfies `retryable`.
 // `f` is retried until `timeout` expires.
 func RetryWhen(ctx context.Context, timeout time.Duration, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
-	var output interface{}
-
-	err := Retry(ctx, timeout, func() *retry.RetryError {
-		var err error
-		var again bool
-
-		output, err = f()
-		again, err = retryable(err)
-
-		if again {
-			return retry.RetryableError(err)
-		}
-
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if TimedOut(err) {
-		output, err = f()
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return RetryGWhen(ctx, timeout, f, retryable)
 }
 
 // RetryGWhen is the generic version of RetryWhen which obviates the need for a type
 // assertion after the call. It retries the function `f` when the error it returns
 // satisfies `retryable`. `f` is retried until `timeout` expires.
 func RetryGWhen[T any](ctx context.Context, timeout time.Duration, f func() (T, error), retryable Retryable) (T, error) {
-	var output T
-
-	err := Retry(ctx, timeout, func() *retry.RetryError {
-		var err error
-		var again bool
-
-		output, err = f()
-		again, err = retryable(err)
-
-		if again {
-			return retry.RetryableError(err)
-		}
-
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
-
-		return nil
+	return retryG(ctx, timeout, f, func(_ T, err error) (bool, error) {
+		return retryable(err)
 	})
+}
 
-	if TimedOut(err) {
-		output, err = f()
-	}
+// retryG runs `f` using the internal/retry package, retrying when `predicate` indicates so.
+// If `timeout` expires, the last error returned by `predicate` is returned.
+func retryG[T any](ctx context.Context, timeout time.Duration, f func() (T, error), predicate tfretry.PredicateFunc[T]) (T, error) {
+	output, err := tfretry.Operation(func(context.Context) (T, error) {
+		return f()
+	}).If(predicate).WithBackoff(compatibleBackoff).Run(ctx, timeout)
 
 	if err != nil {
+		if timeoutErr, ok := errs.As[*tfretry.TimeoutError](err); ok {
+			if timeoutErr.LastError != nil {
+				err = timeoutErr.LastError
+			} else {
+				err = &retry.TimeoutError{Timeout: timeout}
+			}
+		}
+
 		var zero T
 		return zero, err
 	}
@@ -94,132 +67,47 @@ func RetryGWhen[T any](ctx context.Context, timeout time.Duration, f func() (T,
 
 // RetryWhenAWSErrCodeEquals retries the specified function when it returns one of the specified AWS error codes.
 func RetryWhenAWSErrCodeEquals(ctx context.Context, timeout time.Duration, f func() (interface{}, error), codes ...string) (interface{}, error) { // nosemgrep:ci.aws-in-func-name
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if tfawserr.ErrCodeEquals(err, codes...) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryG(ctx, timeout, f, tfretry.WhenErrCodeEquals[interface{}](codes...))
 }
 
 // RetryGWhenAWSErrCodeEquals retries the specified function when it returns one of the specified AWS error codes.
 func RetryGWhenAWSErrCodeEquals[T any](ctx context.Context, timeout time.Duration, f func() (T, error), codes ...string) (T, error) { // nosemgrep:ci.aws-in-func-name
-	return RetryGWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if tfawserr.ErrCodeEquals(err, codes...) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryG(ctx, timeout, f, tfretry.WhenErrCodeEquals[T](codes...))
 }
 
 // RetryWhenAWSErrCodeContains retries the specified function when it returns an AWS error containing the specified code.
 func RetryWhenAWSErrCodeContains(ctx context.Context, timeout time.Duration, f func() (interface{}, error), code string) (interface{}, error) { // nosemgrep:ci.aws-in-func-name
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if tfawserr.ErrCodeContains(err, code) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryG(ctx, timeout, f, tfretry.WhenErrCodeContains[interface{}](code))
 }
 
 // RetryWhenAWSErrMessageContains retries the specified function when it returns an AWS error containing the specified message.
 func RetryWhenAWSErrMessageContains(ctx context.Context, timeout time.Duration, f func() (interface{}, error), code, message string) (interface{}, error) { // nosemgrep:ci.aws-in-func-name
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if tfawserr.ErrMessageContains(err, code, message) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryG(ctx, timeout, f, tfretry.WhenErrMessageContains[interface{}](code, message))
 }
 
 func RetryWhenIsA[T error](ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if errs.IsA[T](err) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryG(ctx, timeout, f, tfretry.WhenIsA[interface{}, T]())
 }
 
 func RetryWhenIsOneOf2[T1, T2 error](ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if errs.IsA[T1](err) || errs.IsA[T2](err) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryG(ctx, timeout, f, tfretry.Or(tfretry.WhenIsA[interface{}, T1](), tfretry.WhenIsA[interface{}, T2]()))
 }
 
 func RetryWhenIsOneOf3[T1, T2, T3 error](ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if errs.IsA[T1](err) || errs.IsA[T2](err) || errs.IsA[T3](err) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryG(ctx, timeout, f, tfretry.Or(tfretry.WhenIsA[interface{}, T1](), tfretry.WhenIsA[interface{}, T2](), tfretry.WhenIsA[interface{}, T3]()))
 }
 
 func RetryWhenIsOneOf4[T1, T2, T3, T4 error](ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if errs.IsA[T1](err) || errs.IsA[T2](err) || errs.IsA[T3](err) || errs.IsA[T4](err) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryG(ctx, timeout, f, tfretry.Or(tfretry.WhenIsA[interface{}, T1](), tfretry.WhenIsA[interface{}, T2](), tfretry.WhenIsA[interface{}, T3](), tfretry.WhenIsA[interface{}, T4]()))
 }
 
 func RetryWhenIsAErrorMessageContains[T errs.ErrorWithErrorMessage](ctx context.Context, timeout time.Duration, f func() (interface{}, error), needle string) (interface{}, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if errs.IsAErrorMessageContains[T](err, needle) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryG(ctx, timeout, f, tfretry.WhenIsAErrorMessageContains[interface{}, T](needle))
 }
 
 // RetryUntilEqual retries the specified function until it returns a value equal to `t`.
 func RetryUntilEqual[T comparable](ctx context.Context, timeout time.Duration, t T, f func() (T, error)) (T, error) {
-	var output T
-
-	err := Retry(ctx, timeout, func() *retry.RetryError {
-		var err error
-
-		output, err = f()
-
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
-
-		if output != t {
-			return retry.RetryableError(fmt.Errorf("output = %v, want %v", output, t))
-		}
-
-		return nil
-	})
-
-	if TimedOut(err) {
-		output, err = f()
-
-		if err == nil && output != t {
-			err = fmt.Errorf("output = %v, want %v", output, t)
-		}
-	}
-
-	if err != nil {
-		var zero T
-		return zero, err
-	}
-
-	return output, nil
+	return retryG(ctx, timeout, f, tfretry.UntilEqual(t))
 }
 
 var ErrFoundResource = errors.New(`found resource`)
@@ -241,34 +129,18 @@ func RetryUntilNotFound(ctx context.Context, timeout time.Duration, f func() (in
 
 // RetryWhenNotFound retries the specified function when it returns a retry.NotFoundError.
 func RetryWhenNotFound(ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if NotFound(err) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryG(ctx, timeout, f, tfretry.WhenNotFound[interface{}]())
 }
 
 // RetryGWhenNotFound retries the specified function when it returns a retry.NotFoundError.
 func RetryGWhenNotFound[T any](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
-	return RetryGWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if NotFound(err) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryG(ctx, timeout, f, tfretry.WhenNotFound[T]())
 }
 
 // RetryWhenNewResourceNotFound retries the specified function when it returns a retry.NotFoundError and `isNewResource` is true.
 func RetryWhenNewResourceNotFound(ctx context.Context, timeout time.Duration, f func() (interface{}, error), isNewResource bool) (interface{}, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if isNewResource && NotFound(err) {
-			return true, err
-		}
-
-		return false, err
+	return retryG(ctx, timeout, f, func(_ interface{}, err error) (bool, error) {
+		return isNewResource && NotFound(err), err
 	})
 }
 
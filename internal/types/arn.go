@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ARNType identifies the service namespace and resource type of an Amazon Resource Name (ARN).
+type ARNType struct {
+	// Service is the ARN's service namespace, e.g. "iam".
+	Service string
+	// ResourcePrefix is the prefix of the ARN's resource that identifies the resource type, e.g. "role/".
+	ResourcePrefix string
+	// Global is true if the ARN has neither a Region nor an account ID, e.g. "arn:aws:s3:::bucket".
+	Global bool
+}
+
+var (
+	ARNTypeIAMRole  = ARNType{Service: "iam", ResourcePrefix: "role/"}
+	ARNTypeKMSAlias = ARNType{Service: "kms", ResourcePrefix: "alias/"}
+	ARNTypeKMSKey   = ARNType{Service: "kms", ResourcePrefix: "key/"}
+	ARNTypeS3Bucket = ARNType{Service: "s3", Global: true}
+)
+
+// String returns the ARN type in the form "service:resource-prefix", or "service:::resource-prefix" for global ARN types.
+func (t ARNType) String() string {
+	if t.Global {
+		return t.Service + ":::" + t.ResourcePrefix
+	}
+
+	return t.Service + ":" + t.ResourcePrefix
+}
+
+// Matches returns whether the specified ARN is of this type.
+func (t ARNType) Matches(a arn.ARN) bool {
+	if a.Service != t.Service {
+		return false
+	}
+
+	if t.Global && (a.Region != "" || a.AccountID != "") {
+		return false
+	}
+
+	return len(a.Resource) > len(t.ResourcePrefix) && strings.HasPrefix(a.Resource, t.ResourcePrefix)
+}
+
+// ValidateARNOfType returns an error if the specified ARN is not of one of the specified types
+// or if its Region is not in its partition.
+func ValidateARNOfType(a arn.ARN, arnTypes ...ARNType) error {
+	if a.Region != "" && !names.IsRegionInPartition(a.Region, a.Partition) {
+		return fmt.Errorf("region %q is not in partition %q", a.Region, a.Partition)
+	}
+
+	if len(arnTypes) == 0 {
+		return nil
+	}
+
+	for _, t := range arnTypes {
+		if t.Matches(a) {
+			return nil
+		}
+	}
+
+	expected := tfslices.ApplyToAll(arnTypes, func(t ARNType) string {
+		return t.String()
+	})
+
+	return fmt.Errorf("expected an ARN of type %s", strings.Join(expected, ", "))
+}
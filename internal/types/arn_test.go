@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+func TestARNTypeString(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		arnType  ARNType
+		expected string
+	}{
+		{ARNTypeIAMRole, "iam:role/"},
+		{ARNTypeKMSKey, "kms:key/"},
+		{ARNTypeS3Bucket, "s3:::"},
+	} {
+		if got, want := ts.arnType.String(), ts.expected; got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestValidateARNOfType(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		arn      string
+		arnTypes []ARNType
+		valid    bool
+	}{
+		{"arn:aws:iam::123456789012:role/example", []ARNType{ARNTypeIAMRole}, true},                                                      // lintignore:AWSAT005
+		{"arn:aws:iam::123456789012:role/service-role/example", []ARNType{ARNTypeIAMRole}, true},                                         // lintignore:AWSAT005
+		{"arn:aws:iam::123456789012:role/", []ARNType{ARNTypeIAMRole}, false},                                                            // lintignore:AWSAT005
+		{"arn:aws:iam::123456789012:user/example", []ARNType{ARNTypeIAMRole}, false},                                                     // lintignore:AWSAT005
+		{"arn:aws:sts::123456789012:assumed-role/example/session", []ARNType{ARNTypeIAMRole}, false},                                     // lintignore:AWSAT005
+		{"arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", []ARNType{ARNTypeKMSKey, ARNTypeKMSAlias}, true}, // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:kms:us-west-2:123456789012:alias/example", []ARNType{ARNTypeKMSKey, ARNTypeKMSAlias}, true},                            // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:kms:us-west-2:123456789012:alias/example", []ARNType{ARNTypeKMSKey}, false},                                            // lintignore:AWSAT003,AWSAT005
+		{"arn:aws-cn:kms:cn-north-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", []ARNType{ARNTypeKMSKey}, true},              // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:kms:cn-north-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", []ARNType{ARNTypeKMSKey}, false},                // lintignore:AWSAT003,AWSAT005
+		{"arn:aws-us-gov:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", nil, false},                               // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", nil, true},                                       // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:s3:::example", []ARNType{ARNTypeS3Bucket}, true},                                                                       // lintignore:AWSAT005
+		{"arn:aws:s3:us-west-2:123456789012:accesspoint/example", []ARNType{ARNTypeS3Bucket}, false},                                     // lintignore:AWSAT003,AWSAT005
+	} {
+		a, err := arn.Parse(ts.arn)
+		if err != nil {
+			t.Fatalf("parsing %q: %s", ts.arn, err)
+		}
+
+		err = ValidateARNOfType(a, ts.arnTypes...)
+		if got, want := err == nil, ts.valid; got != want {
+			t.Errorf("ValidateARNOfType(%q, %v) = %v, want valid %t", ts.arn, ts.arnTypes, err, want)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cedar
+
+import (
+	"strings"
+
+	"github.com/cedar-policy/cedar-go/x/exp/parser"
+)
+
+const (
+	indent = "  "
+)
+
+// Parse parses one or more Cedar policies.
+// Comments are discarded.
+func Parse(text string) (parser.Policies, error) {
+	tokens, err := parser.Tokenize([]byte(text))
+	if err != nil {
+		return nil, err
+	}
+
+	return parser.Parse(tokens)
+}
+
+// Format returns the canonical text of one or more Cedar policies.
+// Each policy is rendered with its annotations first, its scope on separate indented lines and each
+// condition in its own block. Policies are separated by a blank line. Comments are discarded.
+func Format(text string) (string, error) {
+	policies, err := Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	for i, policy := range policies {
+		if i > 0 {
+			sb.WriteString("\n\n")
+		}
+		writePolicy(&sb, policy)
+	}
+
+	return sb.String(), nil
+}
+
+func writePolicy(sb *strings.Builder, policy parser.Policy) {
+	for _, annotation := range policy.Annotations {
+		sb.WriteString(annotation.String())
+		sb.WriteRune('\n')
+	}
+
+	sb.WriteString(string(policy.Effect))
+	sb.WriteString(" (\n")
+	for i, v := range []string{policy.Principal.String(), policy.Action.String(), policy.Resource.String()} {
+		sb.WriteString(indent)
+		sb.WriteString(v)
+		if i < 2 {
+			sb.WriteRune(',')
+		}
+		sb.WriteRune('\n')
+	}
+	sb.WriteRune(')')
+
+	for _, condition := range policy.Conditions {
+		sb.WriteRune('\n')
+		sb.WriteString(string(condition.Type))
+		sb.WriteString(" {\n")
+		sb.WriteString(indent)
+		sb.WriteString(condition.Expression.String())
+		sb.WriteString("\n}")
+	}
+
+	sb.WriteRune(';')
+}
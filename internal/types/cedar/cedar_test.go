@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cedar
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		input       string
+		expected    string
+		expectedErr string
+	}{
+		"empty": {
+			input:    "",
+			expected: "",
+		},
+		"syntax error": {
+			input:       `permit (principal, action resource);`,
+			expectedErr: "parse error",
+		},
+		"missing semicolon": {
+			input:       `permit (principal, action, resource)`,
+			expectedErr: "parse error",
+		},
+		"unscoped": {
+			input: `permit(principal,action,resource);`,
+			expected: `permit (
+  principal,
+  action,
+  resource
+);`,
+		},
+		"scoped with comment": {
+			input: `// Alice can view photos.
+forbid (principal == User::"alice", action in [Action::"view",Action::"edit"],
+	resource is Photo in Album::"vacation")
+	when { context.mfa == false };`,
+			expected: `forbid (
+  principal == User::"alice",
+  action in [Action::"view", Action::"edit"],
+  resource is Photo in Album::"vacation"
+)
+when {
+  context.mfa == false
+};`,
+		},
+		"annotations and multiple policies": {
+			input: `@id("p1") permit (principal, action == Action::"view", resource) unless { principal.suspended };
+permit (principal in Group::"admins", action, resource);`,
+			expected: `@id("p1")
+permit (
+  principal,
+  action == Action::"view",
+  resource
+)
+unless {
+  principal.suspended
+};
+
+permit (
+  principal in Group::"admins",
+  action,
+  resource
+);`,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Format(testcase.input)
+
+			if testcase.expectedErr != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got none", testcase.expectedErr)
+				}
+				if !strings.Contains(err.Error(), testcase.expectedErr) {
+					t.Fatalf("expected error containing %q, got %q", testcase.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			// Formatting is idempotent.
+			again, err := Format(got)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(again, got); diff != "" {
+				t.Errorf("formatting is not idempotent (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
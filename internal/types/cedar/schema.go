@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cedar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cedar-policy/cedar-go/x/exp/parser"
+)
+
+const (
+	actionTypeName = "Action"
+	separator      = "::"
+)
+
+// Schema is the subset of a Cedar schema needed to check the scope of policies.
+// Entity type and action names are fully qualified.
+type Schema struct {
+	entityTypes []string
+	actions     map[string]schemaAction // keyed by fully qualified action entity type and ID, e.g. NS::Action::"view"
+}
+
+type schemaAction struct {
+	// appliesTo is nil when the action does not declare principal and resource types.
+	appliesTo *schemaAppliesTo
+}
+
+type schemaAppliesTo struct {
+	principalTypes []string
+	resourceTypes  []string
+}
+
+type jsonNamespace struct {
+	EntityTypes map[string]json.RawMessage `json:"entityTypes"`
+	Actions     map[string]jsonAction      `json:"actions"`
+}
+
+type jsonAction struct {
+	AppliesTo *jsonAppliesTo `json:"appliesTo"`
+}
+
+type jsonAppliesTo struct {
+	PrincipalTypes []string `json:"principalTypes"`
+	ResourceTypes  []string `json:"resourceTypes"`
+}
+
+// ParseSchema parses a Cedar schema in JSON format.
+func ParseSchema(text string) (*Schema, error) {
+	var namespaces map[string]jsonNamespace
+
+	if err := json.Unmarshal([]byte(text), &namespaces); err != nil {
+		return nil, fmt.Errorf("parsing Cedar schema: %w", err)
+	}
+
+	schema := &Schema{
+		actions: make(map[string]schemaAction),
+	}
+
+	for namespace, v := range namespaces {
+		for name := range v.EntityTypes {
+			schema.entityTypes = append(schema.entityTypes, qualify(namespace, name))
+		}
+
+		for name, action := range v.Actions {
+			var appliesTo *schemaAppliesTo
+			if v := action.AppliesTo; v != nil {
+				appliesTo = &schemaAppliesTo{}
+				for _, v := range v.PrincipalTypes {
+					appliesTo.principalTypes = append(appliesTo.principalTypes, qualify(namespace, v))
+				}
+				for _, v := range v.ResourceTypes {
+					appliesTo.resourceTypes = append(appliesTo.resourceTypes, qualify(namespace, v))
+				}
+			}
+
+			schema.actions[parser.Entity{Path: []string{qualify(namespace, actionTypeName), name}}.String()] = schemaAction{
+				appliesTo: appliesTo,
+			}
+		}
+	}
+
+	return schema, nil
+}
+
+// Validate checks the scope of each policy against the schema.
+// Principal and resource entity types must be declared, actions must be declared and,
+// where a policy names a single principal or resource type and a single action, that action must apply to the type.
+// Conditions are not checked.
+func (s *Schema) Validate(policies parser.Policies) error {
+	var errs []error
+
+	for i, policy := range policies {
+		if err := s.validatePolicy(policy); err != nil {
+			errs = append(errs, fmt.Errorf("policy %d (%s): %w", i, policy.Position, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Schema) validatePolicy(policy parser.Policy) error {
+	var errs []error
+
+	principalType, principalTypes := scopeTypes(policy.Principal.Type, policy.Principal.Path, policy.Principal.Entity)
+	for _, v := range principalTypes {
+		if !slices.Contains(s.entityTypes, v) {
+			errs = append(errs, fmt.Errorf("principal entity type %s is not declared in the schema", v))
+		}
+	}
+
+	resourceType, resourceTypes := scopeTypes(policy.Resource.Type, policy.Resource.Path, policy.Resource.Entity)
+	for _, v := range resourceTypes {
+		if !slices.Contains(s.entityTypes, v) {
+			errs = append(errs, fmt.Errorf("resource entity type %s is not declared in the schema", v))
+		}
+	}
+
+	for _, v := range policy.Action.Entities {
+		if _, ok := s.actions[v.String()]; !ok {
+			errs = append(errs, fmt.Errorf("action %s is not declared in the schema", v))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if policy.Action.Type != parser.MatchEquals {
+		return nil
+	}
+
+	action := policy.Action.Entities[0]
+	appliesTo := s.actions[action.String()].appliesTo
+	if appliesTo == nil {
+		return nil
+	}
+
+	if principalType != "" && !slices.Contains(appliesTo.principalTypes, principalType) {
+		errs = append(errs, fmt.Errorf("action %s does not apply to principal entity type %s", action, principalType))
+	}
+
+	if resourceType != "" && !slices.Contains(appliesTo.resourceTypes, resourceType) {
+		errs = append(errs, fmt.Errorf("action %s does not apply to resource entity type %s", action, resourceType))
+	}
+
+	return errors.Join(errs...)
+}
+
+// scopeTypes returns the entity type that a principal or resource scope constrains the variable to
+// (empty if the type is not fixed) and all the entity types referenced by the scope.
+func scopeTypes(matchType parser.MatchType, path parser.Path, entity parser.Entity) (string, []string) {
+	switch matchType {
+	case parser.MatchEquals:
+		v := entityType(entity)
+		return v, []string{v}
+	case parser.MatchIn:
+		return "", []string{entityType(entity)}
+	case parser.MatchIs:
+		v := path.String()
+		return v, []string{v}
+	case parser.MatchIsIn:
+		v := path.String()
+		return v, []string{v, entityType(entity)}
+	}
+
+	return "", nil
+}
+
+func entityType(entity parser.Entity) string {
+	return strings.Join(entity.Path[:len(entity.Path)-1], separator)
+}
+
+// qualify returns the fully qualified name of an entity type referenced from a namespace.
+func qualify(namespace, name string) string {
+	if namespace == "" || strings.Contains(name, separator) {
+		return name
+	}
+
+	return namespace + separator + name
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cedar
+
+import (
+	"strings"
+	"testing"
+)
+
+const testSchema = `{
+  "PhotoFlash": {
+    "entityTypes": {
+      "User": {"memberOfTypes": ["Group"]},
+      "Group": {},
+      "Photo": {"memberOfTypes": ["Album"]},
+      "Album": {}
+    },
+    "actions": {
+      "view": {"appliesTo": {"principalTypes": ["User"], "resourceTypes": ["Photo", "Album"]}},
+      "delete": {"appliesTo": {"principalTypes": ["PhotoFlash::User"], "resourceTypes": ["Photo"]}},
+      "admin": {}
+    }
+  }
+}`
+
+func TestParseSchema(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		input       string
+		expectedErr string
+	}{
+		"valid": {
+			input: testSchema,
+		},
+		"empty namespace": {
+			input: `{"": {"entityTypes": {"User": {}}, "actions": {"view": {}}}}`,
+		},
+		"invalid JSON": {
+			input:       `{"PhotoFlash":`,
+			expectedErr: "parsing Cedar schema",
+		},
+		"wrong shape": {
+			input:       `{"PhotoFlash": {"entityTypes": []}}`,
+			expectedErr: "parsing Cedar schema",
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSchema(testcase.input)
+
+			if testcase.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testcase.expectedErr) {
+					t.Fatalf("expected error containing %q, got %v", testcase.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestSchemaValidate(t *testing.T) {
+	t.Parallel()
+
+	schema, err := ParseSchema(testSchema)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testcases := map[string]struct {
+		input        string
+		expectedErrs []string
+	}{
+		"unscoped": {
+			input: `permit (principal, action, resource);`,
+		},
+		"valid scope": {
+			input: `permit (principal == PhotoFlash::User::"alice", action == PhotoFlash::Action::"view", resource in PhotoFlash::Album::"vacation");`,
+		},
+		"valid is": {
+			input: `permit (principal is PhotoFlash::User in PhotoFlash::Group::"admins", action in [PhotoFlash::Action::"view", PhotoFlash::Action::"delete"], resource is PhotoFlash::Photo);`,
+		},
+		"action without appliesTo": {
+			input: `permit (principal == PhotoFlash::User::"alice", action == PhotoFlash::Action::"admin", resource);`,
+		},
+		"conditions are not checked": {
+			input: `permit (principal, action, resource) when { resource in Unknown::"x" };`,
+		},
+		"unqualified types": {
+			input: `permit (principal == User::"alice", action == Action::"view", resource);`,
+			expectedErrs: []string{
+				"principal entity type User is not declared",
+				`action Action::"view" is not declared`,
+			},
+		},
+		"undeclared resource type": {
+			input:        `forbid (principal, action, resource is PhotoFlash::Video);`,
+			expectedErrs: []string{"resource entity type PhotoFlash::Video is not declared"},
+		},
+		"undeclared action": {
+			input:        `permit (principal, action in [PhotoFlash::Action::"view", PhotoFlash::Action::"share"], resource);`,
+			expectedErrs: []string{`action PhotoFlash::Action::"share" is not declared`},
+		},
+		"action does not apply": {
+			input: `permit (principal is PhotoFlash::Group, action == PhotoFlash::Action::"delete", resource == PhotoFlash::Album::"vacation");`,
+			expectedErrs: []string{
+				`action PhotoFlash::Action::"delete" does not apply to principal entity type PhotoFlash::Group`,
+				`action PhotoFlash::Action::"delete" does not apply to resource entity type PhotoFlash::Album`,
+			},
+		},
+		"second policy": {
+			input:        `permit (principal, action, resource); permit (principal in PhotoFlash::Team::"a", action, resource);`,
+			expectedErrs: []string{"policy 1", "principal entity type PhotoFlash::Team is not declared"},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			policies, err := Parse(testcase.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err = schema.Validate(policies)
+
+			if len(testcase.expectedErrs) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected errors containing %q, got none", testcase.expectedErrs)
+			}
+			for _, v := range testcase.expectedErrs {
+				if !strings.Contains(err.Error(), v) {
+					t.Errorf("expected error containing %q, got %q", v, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodbjson
+
+import (
+	"strings"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDecodeItem(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		input       string
+		expected    map[string]awstypes.AttributeValue
+		expectedErr string
+	}{
+		"scalars": {
+			input: `{"s":{"S":"one"},"n":{"N":"2"},"b":{"B":"YmxvYg=="},"bool":{"BOOL":true},"null":{"NULL":true}}`,
+			expected: map[string]awstypes.AttributeValue{
+				"s":    &awstypes.AttributeValueMemberS{Value: "one"},
+				"n":    &awstypes.AttributeValueMemberN{Value: "2"},
+				"b":    &awstypes.AttributeValueMemberB{Value: []byte("blob")},
+				"bool": &awstypes.AttributeValueMemberBOOL{Value: true},
+				"null": &awstypes.AttributeValueMemberNULL{Value: true},
+			},
+		},
+		"collections": {
+			input: `{"l":{"L":[{"S":"one"}]},"m":{"M":{"k":{"N":"1"}}},"ss":{"SS":["a","b"]},"ns":{"NS":["1"]}}`,
+			expected: map[string]awstypes.AttributeValue{
+				"l":  &awstypes.AttributeValueMemberL{Value: []awstypes.AttributeValue{&awstypes.AttributeValueMemberS{Value: "one"}}},
+				"m":  &awstypes.AttributeValueMemberM{Value: map[string]awstypes.AttributeValue{"k": &awstypes.AttributeValueMemberN{Value: "1"}}},
+				"ss": &awstypes.AttributeValueMemberSS{Value: []string{"a", "b"}},
+				"ns": &awstypes.AttributeValueMemberNS{Value: []string{"1"}},
+			},
+		},
+		"not an attribute value": {
+			input:       `{"s":"one"}`,
+			expectedErr: "unexpected raw attribute type",
+		},
+		"multiple data type descriptors": {
+			input:       `{"s":{"S":"one","N":"1"}}`,
+			expectedErr: "invalid raw attribute map len: 2",
+		},
+		"wrong element type": {
+			input:       `{"ss":{"SS":[1]}}`,
+			expectedErr: "unexpected raw attribute element type",
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DecodeItem(testcase.input)
+
+			if testcase.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testcase.expectedErr) {
+					t.Fatalf("expected error containing %q, got %v", testcase.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testcase.expected, cmp.AllowUnexported(awstypes.AttributeValueMemberS{}, awstypes.AttributeValueMemberN{}, awstypes.AttributeValueMemberB{}, awstypes.AttributeValueMemberBOOL{}, awstypes.AttributeValueMemberNULL{}, awstypes.AttributeValueMemberL{}, awstypes.AttributeValueMemberM{}, awstypes.AttributeValueMemberSS{}, awstypes.AttributeValueMemberNS{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestEncodeItemRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	input := `{"b":{"B":"YmxvYg=="},"l":{"L":[{"S":"one"},{"NULL":true}]},"m":{"M":{"k":{"BOOL":false}}},"n":{"N":"2"}}`
+
+	item, err := DecodeItem(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := EncodeItem(item)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got = strings.TrimSpace(got); got != input {
+		t.Errorf("got %s, expected %s", got, input)
+	}
+}
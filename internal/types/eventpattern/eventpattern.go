@@ -0,0 +1,548 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventpattern
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+var ErrSyntax = errors.New("invalid event pattern")
+
+const (
+	keyOr = "$or"
+
+	matcherAnythingBut      = "anything-but"
+	matcherCIDR             = "cidr"
+	matcherEqualsIgnoreCase = "equals-ignore-case"
+	matcherExists           = "exists"
+	matcherNumeric          = "numeric"
+	matcherPrefix           = "prefix"
+	matcherSuffix           = "suffix"
+	matcherWildcard         = "wildcard"
+)
+
+// Pattern is a parsed Amazon EventBridge event pattern.
+//
+// See https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns.html and
+// https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-create-pattern-operators.html.
+type Pattern struct {
+	root map[string]any
+}
+
+// Parse parses and validates an event pattern.
+// Errors wrap ErrSyntax and describe the offending part of the pattern.
+func Parse(s string) (Pattern, error) {
+	var v any
+	if err := unmarshal(s, &v); err != nil {
+		return Pattern{}, fmt.Errorf("%w: %w", ErrSyntax, err)
+	}
+
+	root, ok := v.(map[string]any)
+	if !ok {
+		return Pattern{}, fmt.Errorf("%w: pattern must be a JSON object", ErrSyntax)
+	}
+	if len(root) == 0 {
+		return Pattern{}, fmt.Errorf("%w: pattern must not be empty", ErrSyntax)
+	}
+
+	if err := validateObject(root, ""); err != nil {
+		return Pattern{}, fmt.Errorf("%w: %w", ErrSyntax, err)
+	}
+
+	return Pattern{root: root}, nil
+}
+
+// Matches reports whether the JSON event matches the pattern.
+func (p Pattern) Matches(event string) (bool, error) {
+	var v any
+	if err := unmarshal(event, &v); err != nil {
+		return false, fmt.Errorf("invalid event: %w", err)
+	}
+
+	if _, ok := v.(map[string]any); !ok {
+		return false, errors.New("invalid event: event must be a JSON object")
+	}
+
+	return matchObject(p.root, v), nil
+}
+
+// Matches parses the pattern and reports whether the JSON event matches it.
+func Matches(pattern, event string) (bool, error) {
+	p, err := Parse(pattern)
+	if err != nil {
+		return false, err
+	}
+
+	return p.Matches(event)
+}
+
+func unmarshal(s string, v any) error {
+	decoder := json.NewDecoder(strings.NewReader(s))
+
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+	if decoder.More() {
+		return errors.New("unexpected data after top-level value")
+	}
+
+	return nil
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func validateObject(object map[string]any, path string) error {
+	for key, v := range object {
+		fieldPath := joinPath(path, key)
+
+		if key == keyOr {
+			alternatives, ok := v.([]any)
+			if !ok || len(alternatives) < 2 {
+				return fmt.Errorf("%q must be an array of at least two patterns", fieldPath)
+			}
+
+			for _, alternative := range alternatives {
+				alternative, ok := alternative.(map[string]any)
+				if !ok || len(alternative) == 0 {
+					return fmt.Errorf("%q must contain only non-empty objects", fieldPath)
+				}
+
+				if err := validateObject(alternative, path); err != nil {
+					return err
+				}
+			}
+
+			continue
+		}
+
+		switch v := v.(type) {
+		case map[string]any:
+			if len(v) == 0 {
+				return fmt.Errorf("%q must not be an empty object", fieldPath)
+			}
+
+			if err := validateObject(v, fieldPath); err != nil {
+				return err
+			}
+		case []any:
+			if len(v) == 0 {
+				return fmt.Errorf("%q must not be an empty array", fieldPath)
+			}
+
+			for _, matcher := range v {
+				if err := validateMatcher(matcher, fieldPath); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("%q must be an object or an array", fieldPath)
+		}
+	}
+
+	return nil
+}
+
+func validateMatcher(matcher any, path string) error {
+	object, ok := matcher.(map[string]any)
+	if !ok {
+		// Exact match on a string, number, boolean or null.
+		return nil
+	}
+
+	if len(object) != 1 {
+		return fmt.Errorf("%q: content filters must have exactly one key", path)
+	}
+
+	for name, v := range object {
+		switch name {
+		case matcherAnythingBut:
+			return validateAnythingBut(v, path)
+		case matcherCIDR:
+			s, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("%q: %q must be a string", path, name)
+			}
+			if _, _, err := net.ParseCIDR(s); err != nil {
+				return fmt.Errorf("%q: %q is not a valid CIDR block", path, s)
+			}
+		case matcherEqualsIgnoreCase, matcherWildcard:
+			if _, ok := v.(string); !ok {
+				return fmt.Errorf("%q: %q must be a string", path, name)
+			}
+		case matcherExists:
+			if _, ok := v.(bool); !ok {
+				return fmt.Errorf("%q: %q must be a boolean", path, name)
+			}
+		case matcherNumeric:
+			return validateNumeric(v, path)
+		case matcherPrefix, matcherSuffix:
+			if _, ok := v.(string); ok {
+				return nil
+			}
+			if _, ok := ignoreCaseOperand(v); !ok {
+				return fmt.Errorf("%q: %q must be a string or an %q object", path, name, matcherEqualsIgnoreCase)
+			}
+		default:
+			return fmt.Errorf("%q: unsupported content filter %q", path, name)
+		}
+	}
+
+	return nil
+}
+
+func validateAnythingBut(v any, path string) error {
+	switch v := v.(type) {
+	case string, float64, bool:
+		return nil
+	case []any:
+		if len(v) == 0 {
+			return fmt.Errorf("%q: %q must not be an empty array", path, matcherAnythingBut)
+		}
+		for _, v := range v {
+			switch v.(type) {
+			case string, float64:
+			default:
+				return fmt.Errorf("%q: %q arrays must contain only strings or numbers", path, matcherAnythingBut)
+			}
+		}
+		return nil
+	case map[string]any:
+		if len(v) != 1 {
+			return fmt.Errorf("%q: %q objects must have exactly one key", path, matcherAnythingBut)
+		}
+		for name, v := range v {
+			switch name {
+			case matcherPrefix, matcherSuffix:
+				if _, ok := v.(string); !ok {
+					return fmt.Errorf("%q: %q %q must be a string", path, matcherAnythingBut, name)
+				}
+			case matcherEqualsIgnoreCase, matcherWildcard:
+				if _, ok := stringOrStrings(v); !ok {
+					return fmt.Errorf("%q: %q %q must be a string or an array of strings", path, matcherAnythingBut, name)
+				}
+			default:
+				return fmt.Errorf("%q: unsupported %q filter %q", path, matcherAnythingBut, name)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("%q: %q must be a string, number, boolean, array or object", path, matcherAnythingBut)
+	}
+}
+
+func validateNumeric(v any, path string) error {
+	operands, ok := v.([]any)
+	if !ok || len(operands) == 0 || len(operands)%2 != 0 {
+		return fmt.Errorf("%q: %q must be an array of operator and number pairs", path, matcherNumeric)
+	}
+
+	var lower, upper int
+	for i := 0; i < len(operands); i += 2 {
+		operator, ok := operands[i].(string)
+		if !ok {
+			return fmt.Errorf("%q: %q operator must be a string", path, matcherNumeric)
+		}
+		if _, ok := operands[i+1].(float64); !ok {
+			return fmt.Errorf("%q: %q operand of %q must be a number", path, matcherNumeric, operator)
+		}
+
+		switch operator {
+		case "=":
+			if len(operands) != 2 {
+				return fmt.Errorf("%q: %q operator %q cannot be combined with other operators", path, matcherNumeric, operator)
+			}
+		case ">", ">=":
+			lower++
+		case "<", "<=":
+			upper++
+		default:
+			return fmt.Errorf("%q: unsupported %q operator %q", path, matcherNumeric, operator)
+		}
+	}
+
+	if lower > 1 || upper > 1 {
+		return fmt.Errorf("%q: %q supports at most one lower and one upper bound", path, matcherNumeric)
+	}
+
+	return nil
+}
+
+func ignoreCaseOperand(v any) (string, bool) {
+	object, ok := v.(map[string]any)
+	if !ok || len(object) != 1 {
+		return "", false
+	}
+
+	s, ok := object[matcherEqualsIgnoreCase].(string)
+
+	return s, ok
+}
+
+func stringOrStrings(v any) ([]string, bool) {
+	switch v := v.(type) {
+	case string:
+		return []string{v}, true
+	case []any:
+		if len(v) == 0 {
+			return nil, false
+		}
+
+		var ss []string
+		for _, v := range v {
+			s, ok := v.(string)
+			if !ok {
+				return nil, false
+			}
+			ss = append(ss, s)
+		}
+
+		return ss, true
+	default:
+		return nil, false
+	}
+}
+
+// matchObject reports whether every field of the pattern object matches the event value.
+// A nil event value represents a missing field.
+func matchObject(pattern map[string]any, event any) bool {
+	// An array of objects matches if any of its elements matches.
+	if elements, ok := event.([]any); ok {
+		return slices.ContainsFunc(elements, func(element any) bool {
+			return matchObject(pattern, element)
+		})
+	}
+
+	object, _ := event.(map[string]any)
+
+	for key, v := range pattern {
+		if key == keyOr {
+			if !slices.ContainsFunc(v.([]any), func(alternative any) bool {
+				return matchObject(alternative.(map[string]any), event)
+			}) {
+				return false
+			}
+
+			continue
+		}
+
+		field, present := object[key]
+
+		switch v := v.(type) {
+		case map[string]any:
+			if !present {
+				field = nil
+			}
+
+			if !matchObject(v, field) {
+				return false
+			}
+		case []any:
+			if !matchField(v, field, present) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// matchField reports whether any of the matchers matches the event field.
+func matchField(matchers []any, field any, present bool) bool {
+	var values []any
+
+	switch field := field.(type) {
+	case []any:
+		for _, v := range field {
+			// Only leaf values take part in content filtering.
+			if _, ok := v.(map[string]any); !ok {
+				values = append(values, v)
+			}
+		}
+	case map[string]any:
+	default:
+		if present {
+			values = []any{field}
+		}
+	}
+
+	return slices.ContainsFunc(matchers, func(matcher any) bool {
+		if object, ok := matcher.(map[string]any); ok {
+			if exists, ok := object[matcherExists].(bool); ok {
+				return exists == (len(values) > 0)
+			}
+		}
+
+		return slices.ContainsFunc(values, func(value any) bool {
+			return matchValue(matcher, value)
+		})
+	})
+}
+
+func matchValue(matcher any, value any) bool {
+	object, ok := matcher.(map[string]any)
+	if !ok {
+		return equal(matcher, value)
+	}
+
+	for name, v := range object {
+		switch name {
+		case matcherAnythingBut:
+			return matchAnythingBut(v, value)
+		case matcherCIDR:
+			s, ok := value.(string)
+			if !ok {
+				return false
+			}
+			ip := net.ParseIP(s)
+			_, network, _ := net.ParseCIDR(v.(string))
+			return ip != nil && network.Contains(ip)
+		case matcherEqualsIgnoreCase:
+			s, ok := value.(string)
+			return ok && strings.EqualFold(s, v.(string))
+		case matcherNumeric:
+			n, ok := value.(float64)
+			return ok && matchNumeric(v.([]any), n)
+		case matcherPrefix:
+			s, ok := value.(string)
+			if !ok {
+				return false
+			}
+			if prefix, ok := v.(string); ok {
+				return strings.HasPrefix(s, prefix)
+			}
+			prefix, _ := ignoreCaseOperand(v)
+			return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+		case matcherSuffix:
+			s, ok := value.(string)
+			if !ok {
+				return false
+			}
+			if suffix, ok := v.(string); ok {
+				return strings.HasSuffix(s, suffix)
+			}
+			suffix, _ := ignoreCaseOperand(v)
+			return len(s) >= len(suffix) && strings.EqualFold(s[len(s)-len(suffix):], suffix)
+		case matcherWildcard:
+			s, ok := value.(string)
+			return ok && wildcardRegexp(v.(string)).MatchString(s)
+		}
+	}
+
+	return false
+}
+
+func matchAnythingBut(v any, value any) bool {
+	switch v := v.(type) {
+	case []any:
+		return !slices.ContainsFunc(v, func(v any) bool {
+			return equal(v, value)
+		})
+	case map[string]any:
+		s, ok := value.(string)
+
+		for name, v := range v {
+			switch name {
+			case matcherPrefix:
+				return !ok || !strings.HasPrefix(s, v.(string))
+			case matcherSuffix:
+				return !ok || !strings.HasSuffix(s, v.(string))
+			case matcherEqualsIgnoreCase:
+				operands, _ := stringOrStrings(v)
+				return !ok || !slices.ContainsFunc(operands, func(operand string) bool {
+					return strings.EqualFold(s, operand)
+				})
+			case matcherWildcard:
+				operands, _ := stringOrStrings(v)
+				return !ok || !slices.ContainsFunc(operands, func(operand string) bool {
+					return wildcardRegexp(operand).MatchString(s)
+				})
+			}
+		}
+
+		return false
+	default:
+		return !equal(v, value)
+	}
+}
+
+func matchNumeric(operands []any, n float64) bool {
+	for i := 0; i < len(operands); i += 2 {
+		operand := operands[i+1].(float64)
+
+		var ok bool
+		switch operands[i].(string) {
+		case "=":
+			ok = n == operand
+		case ">":
+			ok = n > operand
+		case ">=":
+			ok = n >= operand
+		case "<":
+			ok = n < operand
+		case "<=":
+			ok = n <= operand
+		}
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equal(a, b any) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case bool:
+		b, ok := b.(bool)
+		return ok && a == b
+	case float64:
+		b, ok := b.(float64)
+		return ok && a == b
+	case string:
+		b, ok := b.(string)
+		return ok && a == b
+	default:
+		return false
+	}
+}
+
+// wildcardRegexp returns a regular expression equivalent to a wildcard filter,
+// in which "*" matches any sequence of characters and "\" escapes "*" and "\".
+func wildcardRegexp(pattern string) *regexp.Regexp {
+	var buf bytes.Buffer
+
+	buf.WriteString(`^`)
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			buf.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		case c == '*':
+			buf.WriteString(`.*`)
+		default:
+			buf.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	buf.WriteString(`$`)
+
+	return regexache.MustCompile(`(?s)` + buf.String())
+}
@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventpattern
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		input       string
+		expectedErr string
+	}{
+		"empty": {
+			input:       "",
+			expectedErr: "EOF",
+		},
+		"not an object": {
+			input:       `["aws.ec2"]`,
+			expectedErr: "must be a JSON object",
+		},
+		"empty object": {
+			input:       `{}`,
+			expectedErr: "must not be empty",
+		},
+		"trailing data": {
+			input:       `{"source": ["aws.ec2"]} {}`,
+			expectedErr: "unexpected data",
+		},
+		"scalar field": {
+			input:       `{"source": "aws.ec2"}`,
+			expectedErr: `"source" must be an object or an array`,
+		},
+		"empty array": {
+			input:       `{"detail": {"state": []}}`,
+			expectedErr: `"detail.state" must not be an empty array`,
+		},
+		"unsupported filter": {
+			input:       `{"source": [{"regex": "aws.*"}]}`,
+			expectedErr: `unsupported content filter "regex"`,
+		},
+		"filter with two keys": {
+			input:       `{"source": [{"prefix": "aws.", "suffix": "ec2"}]}`,
+			expectedErr: "exactly one key",
+		},
+		"numeric odd operands": {
+			input:       `{"detail": {"size": [{"numeric": [">", 0, "<"]}]}}`,
+			expectedErr: "operator and number pairs",
+		},
+		"numeric string operand": {
+			input:       `{"detail": {"size": [{"numeric": [">", "0"]}]}}`,
+			expectedErr: "must be a number",
+		},
+		"numeric two lower bounds": {
+			input:       `{"detail": {"size": [{"numeric": [">", 0, ">=", 5]}]}}`,
+			expectedErr: "at most one lower and one upper bound",
+		},
+		"numeric equals combined": {
+			input:       `{"detail": {"size": [{"numeric": ["=", 0, "<", 5]}]}}`,
+			expectedErr: "cannot be combined",
+		},
+		"invalid cidr": {
+			input:       `{"detail": {"ip": [{"cidr": "10.0.0.0/33"}]}}`,
+			expectedErr: "not a valid CIDR block",
+		},
+		"exists not boolean": {
+			input:       `{"detail": {"ip": [{"exists": "true"}]}}`,
+			expectedErr: "must be a boolean",
+		},
+		"or single alternative": {
+			input:       `{"$or": [{"source": ["aws.ec2"]}]}`,
+			expectedErr: "at least two patterns",
+		},
+		"invalid nested in or": {
+			input:       `{"$or": [{"source": ["aws.ec2"]}, {"detail-type": "x"}]}`,
+			expectedErr: `"detail-type" must be an object or an array`,
+		},
+		"anything-but unsupported": {
+			input:       `{"source": [{"anything-but": {"numeric": [">", 0]}}]}`,
+			expectedErr: `unsupported "anything-but" filter "numeric"`,
+		},
+
+		"valid": {
+			input: `{
+  "source": ["aws.ec2", {"prefix": "custom."}],
+  "detail": {
+    "state": [{"anything-but": ["terminated", "stopped"]}],
+    "size": [{"numeric": [">", 0, "<=", 100]}],
+    "ip": [{"cidr": "10.0.0.0/8"}],
+    "tag": [{"exists": true}]
+  },
+  "$or": [
+    {"region": ["us-west-2"]},
+    {"account": [{"wildcard": "1234*"}]}
+  ]
+}`,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(testcase.input)
+
+			if testcase.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", testcase.expectedErr)
+			}
+			if !errors.Is(err, ErrSyntax) {
+				t.Errorf("expected error to wrap ErrSyntax: %s", err)
+			}
+			if !strings.Contains(err.Error(), testcase.expectedErr) {
+				t.Errorf("expected error containing %q, got %q", testcase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	t.Parallel()
+
+	const event = `{
+  "version": "0",
+  "id": "6a7e8feb-b491-4cf7-a9f1-bf3703467718",
+  "detail-type": "EC2 Instance State-change Notification",
+  "source": "aws.ec2",
+  "account": "111122223333",
+  "region": "us-west-2",
+  "resources": ["arn:aws:ec2:us-west-2:111122223333:instance/i-1234567890abcdef0"],
+  "detail": {
+    "instance-id": "i-1234567890abcdef0",
+    "state": "running",
+    "size": 42,
+    "enabled": true,
+    "owner": null,
+    "source-ip": "10.0.12.34",
+    "file": "logs/App.LOG",
+    "tags": ["blue", "green"],
+    "attachments": [
+      {"type": "ebs", "size": 8},
+      {"type": "eni"}
+    ]
+  }
+}`
+
+	testcases := map[string]struct {
+		pattern  string
+		expected bool
+	}{
+		"exact string": {
+			pattern:  `{"source": ["aws.ec2"]}`,
+			expected: true,
+		},
+		"exact string mismatch": {
+			pattern:  `{"source": ["aws.s3"]}`,
+			expected: false,
+		},
+		"any of values": {
+			pattern:  `{"detail": {"state": ["pending", "running"]}}`,
+			expected: true,
+		},
+		"exact number": {
+			pattern:  `{"detail": {"size": [42]}}`,
+			expected: true,
+		},
+		"number does not match string": {
+			pattern:  `{"detail": {"size": ["42"]}}`,
+			expected: false,
+		},
+		"exact boolean": {
+			pattern:  `{"detail": {"enabled": [true]}}`,
+			expected: true,
+		},
+		"exact null": {
+			pattern:  `{"detail": {"owner": [null]}}`,
+			expected: true,
+		},
+		"all fields must match": {
+			pattern:  `{"source": ["aws.ec2"], "region": ["eu-west-1"]}`,
+			expected: false,
+		},
+		"missing field": {
+			pattern:  `{"detail": {"missing": ["x"]}}`,
+			expected: false,
+		},
+		"event array any element": {
+			pattern:  `{"detail": {"tags": ["green"]}}`,
+			expected: true,
+		},
+		"event array of objects": {
+			pattern:  `{"detail": {"attachments": {"type": ["eni"]}}}`,
+			expected: true,
+		},
+		"event array of objects fields in one element": {
+			pattern:  `{"detail": {"attachments": {"type": ["eni"], "size": [8]}}}`,
+			expected: false,
+		},
+		"prefix": {
+			pattern:  `{"detail-type": [{"prefix": "EC2 "}]}`,
+			expected: true,
+		},
+		"prefix case sensitive": {
+			pattern:  `{"detail-type": [{"prefix": "ec2 "}]}`,
+			expected: false,
+		},
+		"prefix ignore case": {
+			pattern:  `{"detail-type": [{"prefix": {"equals-ignore-case": "ec2 "}}]}`,
+			expected: true,
+		},
+		"suffix": {
+			pattern:  `{"detail": {"file": [{"suffix": ".LOG"}]}}`,
+			expected: true,
+		},
+		"suffix ignore case": {
+			pattern:  `{"detail": {"file": [{"suffix": {"equals-ignore-case": ".log"}}]}}`,
+			expected: true,
+		},
+		"equals ignore case": {
+			pattern:  `{"detail": {"state": [{"equals-ignore-case": "RUNNING"}]}}`,
+			expected: true,
+		},
+		"anything-but string": {
+			pattern:  `{"detail": {"state": [{"anything-but": "stopped"}]}}`,
+			expected: true,
+		},
+		"anything-but list": {
+			pattern:  `{"detail": {"state": [{"anything-but": ["stopped", "running"]}]}}`,
+			expected: false,
+		},
+		"anything-but number": {
+			pattern:  `{"detail": {"size": [{"anything-but": [42]}]}}`,
+			expected: false,
+		},
+		"anything-but prefix": {
+			pattern:  `{"source": [{"anything-but": {"prefix": "aws."}}]}`,
+			expected: false,
+		},
+		"anything-but suffix": {
+			pattern:  `{"source": [{"anything-but": {"suffix": ".s3"}}]}`,
+			expected: true,
+		},
+		"anything-but ignore case": {
+			pattern:  `{"detail": {"state": [{"anything-but": {"equals-ignore-case": ["RUNNING", "PENDING"]}}]}}`,
+			expected: false,
+		},
+		"anything-but wildcard": {
+			pattern:  `{"detail": {"file": [{"anything-but": {"wildcard": "logs/*"}}]}}`,
+			expected: false,
+		},
+		"anything-but missing field": {
+			pattern:  `{"detail": {"missing": [{"anything-but": "x"}]}}`,
+			expected: false,
+		},
+		"numeric range": {
+			pattern:  `{"detail": {"size": [{"numeric": [">", 0, "<=", 42]}]}}`,
+			expected: true,
+		},
+		"numeric out of range": {
+			pattern:  `{"detail": {"size": [{"numeric": [">=", 43]}]}}`,
+			expected: false,
+		},
+		"numeric equals": {
+			pattern:  `{"detail": {"size": [{"numeric": ["=", 42]}]}}`,
+			expected: true,
+		},
+		"numeric on string": {
+			pattern:  `{"detail": {"state": [{"numeric": [">", 0]}]}}`,
+			expected: false,
+		},
+		"exists true": {
+			pattern:  `{"detail": {"state": [{"exists": true}]}}`,
+			expected: true,
+		},
+		"exists true missing": {
+			pattern:  `{"detail": {"missing": [{"exists": true}]}}`,
+			expected: false,
+		},
+		"exists false missing": {
+			pattern:  `{"detail": {"missing": [{"exists": false}]}}`,
+			expected: true,
+		},
+		"exists false present": {
+			pattern:  `{"detail": {"state": [{"exists": false}]}}`,
+			expected: false,
+		},
+		"exists false missing parent": {
+			pattern:  `{"missing": {"child": [{"exists": false}]}}`,
+			expected: true,
+		},
+		"exists true object": {
+			pattern:  `{"detail": [{"exists": true}]}`,
+			expected: false,
+		},
+		"cidr": {
+			pattern:  `{"detail": {"source-ip": [{"cidr": "10.0.0.0/16"}]}}`,
+			expected: true,
+		},
+		"cidr mismatch": {
+			pattern:  `{"detail": {"source-ip": [{"cidr": "192.168.0.0/16"}]}}`,
+			expected: false,
+		},
+		"wildcard": {
+			pattern:  `{"resources": [{"wildcard": "arn:aws:ec2:*:111122223333:instance/*"}]}`,
+			expected: true,
+		},
+		"wildcard mismatch": {
+			pattern:  `{"resources": [{"wildcard": "arn:aws:s3:::*"}]}`,
+			expected: false,
+		},
+		"wildcard escaped": {
+			pattern:  `{"detail": {"file": [{"wildcard": "logs\\*"}]}}`,
+			expected: false,
+		},
+		"or first": {
+			pattern:  `{"$or": [{"source": ["aws.ec2"]}, {"detail": {"state": ["stopped"]}}]}`,
+			expected: true,
+		},
+		"or second": {
+			pattern:  `{"$or": [{"source": ["aws.s3"]}, {"detail": {"state": ["running"]}}]}`,
+			expected: true,
+		},
+		"or none": {
+			pattern:  `{"$or": [{"source": ["aws.s3"]}, {"detail": {"state": ["stopped"]}}]}`,
+			expected: false,
+		},
+		"or nested": {
+			pattern:  `{"source": ["aws.ec2"], "detail": {"$or": [{"size": [{"numeric": [">", 100]}]}, {"enabled": [true]}]}}`,
+			expected: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Matches(testcase.pattern, event)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testcase.expected {
+				t.Errorf("Matches(%s) = %t, want %t", testcase.pattern, got, testcase.expected)
+			}
+		})
+	}
+}
+
+func TestMatchesInvalidEvent(t *testing.T) {
+	t.Parallel()
+
+	for _, event := range []string{``, `[]`, `{"source": "aws.ec2"`} {
+		if _, err := Matches(`{"source": ["aws.ec2"]}`, event); err == nil {
+			t.Errorf("expected error for event %q", event)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// ValidateIPAddress validates that the specified IP address is a valid IPv4 or IPv6 address.
+func ValidateIPAddress(address string) error {
+	if _, err := netip.ParseAddr(address); err != nil {
+		return fmt.Errorf("%q is not a valid IP address: %w", address, err)
+	}
+
+	return nil
+}
+
+// IPAddressesEqual returns whether or not two IP addresses are equal:
+// - Both IP addresses parse
+// - The parsed addresses are equal
+// This function is especially useful for IPv6 addresses which have multiple valid representations.
+func IPAddressesEqual(address1, address2 string) bool {
+	addr1, err := netip.ParseAddr(address1)
+	if err != nil {
+		return false
+	}
+	addr2, err := netip.ParseAddr(address2)
+	if err != nil {
+		return false
+	}
+
+	return addr1 == addr2
+}
+
+// CanonicalIPAddress returns the canonical (RFC 5952) representation of an IP address.
+func CanonicalIPAddress(address string) string {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return address
+	}
+
+	return addr.String()
+}
+
+// ValidateIPAddressOrCIDR validates that the specified value is either a valid IP address
+// or a valid CIDR block (see ValidateCIDRBlock).
+func ValidateIPAddressOrCIDR(value string) error {
+	if strings.Contains(value, "/") {
+		return ValidateCIDRBlock(value)
+	}
+
+	return ValidateIPAddress(value)
+}
+
+// IPAddressOrCIDRsEqual returns whether or not two values, each either an IP address or a CIDR block, are equal.
+// An IP address is never equal to a CIDR block, even a host (/32 or /128) CIDR block.
+func IPAddressOrCIDRsEqual(value1, value2 string) bool {
+	isCIDR1, isCIDR2 := strings.Contains(value1, "/"), strings.Contains(value2, "/")
+
+	switch {
+	case isCIDR1 && isCIDR2:
+		return CIDRBlocksEqual(value1, value2)
+	case !isCIDR1 && !isCIDR2:
+		return IPAddressesEqual(value1, value2)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import "testing"
+
+func TestValidateIPAddress(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		address string
+		valid   bool
+	}{
+		{"10.2.2.1", true},
+		{"10.2.2.256", false},
+		{"2001:db8::1", true},
+		{"2001:DB8:0:0:0:0:0:1", true},
+		{"2001:db8::/32", false},
+		{"10.2.2.0/24", false},
+		{"", false},
+	} {
+		err := ValidateIPAddress(ts.address)
+		if !ts.valid && err == nil {
+			t.Fatalf("Input '%s' should error but didn't!", ts.address)
+		}
+		if ts.valid && err != nil {
+			t.Fatalf("Got unexpected error for '%s' input: %s", ts.address, err)
+		}
+	}
+}
+
+func TestIPAddressesEqual(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		address1 string
+		address2 string
+		equal    bool
+	}{
+		{"10.2.2.1", "10.2.2.1", true},
+		{"10.2.2.1", "10.2.2.2", false},
+		{"2001:db8::1", "2001:DB8:0:0:0:0:0:1", true},
+		{"2001:db8::1", "2001:0db8:0000:0000:0000:0000:0000:0001", true},
+		{"2001:db8::1", "2001:db8::2", false},
+		{"10.2.2.1", "::ffff:10.2.2.1", false},
+		{"", "", false},
+	} {
+		equal := IPAddressesEqual(ts.address1, ts.address2)
+		if ts.equal != equal {
+			t.Fatalf("IPAddressesEqual(%q, %q) should be: %t", ts.address1, ts.address2, ts.equal)
+		}
+	}
+}
+
+func TestCanonicalIPAddress(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		address  string
+		expected string
+	}{
+		{"10.2.2.1", "10.2.2.1"},
+		{"2001:DB8:0:0:0:0:0:1", "2001:db8::1"},
+		{"2001:0db8:0000:0000:0001:0000:0000:0001", "2001:db8::1:0:0:1"},
+		{"not-an-address", "not-an-address"},
+		{"", ""},
+	} {
+		got := CanonicalIPAddress(ts.address)
+		if ts.expected != got {
+			t.Fatalf("CanonicalIPAddress(%q) should be: %q, got: %q", ts.address, ts.expected, got)
+		}
+	}
+}
+
+func TestValidateIPAddressOrCIDR(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		value string
+		valid bool
+	}{
+		{"10.2.2.1", true},
+		{"10.2.2.0/24", true},
+		{"10.2.2.2/24", false},
+		{"2001:db8::1", true},
+		{"2001:DB8::/32", true},
+		{"2001:db8::1/32", false},
+		{"", false},
+	} {
+		err := ValidateIPAddressOrCIDR(ts.value)
+		if !ts.valid && err == nil {
+			t.Fatalf("Input '%s' should error but didn't!", ts.value)
+		}
+		if ts.valid && err != nil {
+			t.Fatalf("Got unexpected error for '%s' input: %s", ts.value, err)
+		}
+	}
+}
+
+func TestIPAddressOrCIDRsEqual(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		value1 string
+		value2 string
+		equal  bool
+	}{
+		{"10.2.2.1", "10.2.2.1", true},
+		{"10.2.2.0/24", "10.2.2.0/24", true},
+		{"10.2.2.1", "10.2.2.1/32", false},
+		{"2001:db8::1", "2001:DB8::1", true},
+		{"2001:DB8::/32", "2001:db8:0::/32", true},
+		{"2001:db8::/32", "2001:db8::/48", false},
+		{"", "", false},
+	} {
+		equal := IPAddressOrCIDRsEqual(ts.value1, ts.value2)
+		if ts.equal != equal {
+			t.Fatalf("IPAddressOrCIDRsEqual(%q, %q) should be: %t", ts.value1, ts.value2, ts.equal)
+		}
+	}
+}
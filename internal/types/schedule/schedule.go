@@ -0,0 +1,572 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrSyntax = errors.New("invalid syntax")
+
+// Kind is the kind of a schedule expression.
+type Kind string
+
+const (
+	KindAt   Kind = "at"
+	KindCron Kind = "cron"
+	KindRate Kind = "rate"
+)
+
+const (
+	// AtLayout is the layout of the timestamp in an at() expression.
+	AtLayout = "2006-01-02T15:04:05"
+
+	minYear = 1970
+	maxYear = 2199
+)
+
+// Expression is a parsed AWS schedule expression, one of
+//
+//   - cron(minutes hours day-of-month month day-of-week year)
+//   - rate(value unit)
+//   - at(yyyy-mm-ddThh:mm:ss)
+//
+// See https://docs.aws.amazon.com/scheduler/latest/UserGuide/schedule-types.html and
+// https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html.
+type Expression struct {
+	kind Kind
+	cron *cron
+	rate time.Duration
+	at   time.Time
+}
+
+// Parse parses an AWS schedule expression.
+// Errors wrap ErrSyntax and describe the offending part of the expression.
+func Parse(s string) (Expression, error) {
+	kind, body, ok := splitExpression(s)
+	if !ok {
+		return Expression{}, fmt.Errorf("%w: %q must be of the form cron(...), rate(...) or at(...)", ErrSyntax, s)
+	}
+
+	switch kind {
+	case KindAt:
+		t, err := time.Parse(AtLayout, body)
+		if err != nil {
+			return Expression{}, fmt.Errorf("%w: at(%s): timestamp must be of the form yyyy-mm-ddThh:mm:ss", ErrSyntax, body)
+		}
+		return Expression{kind: kind, at: t}, nil
+
+	case KindCron:
+		c, err := parseCron(body)
+		if err != nil {
+			return Expression{}, fmt.Errorf("%w: cron(%s): %s", ErrSyntax, body, err)
+		}
+		return Expression{kind: kind, cron: c}, nil
+
+	case KindRate:
+		d, err := parseRate(body)
+		if err != nil {
+			return Expression{}, fmt.Errorf("%w: rate(%s): %s", ErrSyntax, body, err)
+		}
+		return Expression{kind: kind, rate: d}, nil
+	}
+
+	return Expression{}, fmt.Errorf("%w: unsupported schedule expression kind %q", ErrSyntax, kind)
+}
+
+func splitExpression(s string) (Kind, string, bool) {
+	s = strings.TrimSpace(s)
+
+	kind, rest, ok := strings.Cut(s, "(")
+	if !ok || !strings.HasSuffix(rest, ")") {
+		return "", "", false
+	}
+
+	switch kind := Kind(kind); kind {
+	case KindAt, KindCron, KindRate:
+		return kind, strings.TrimSpace(strings.TrimSuffix(rest, ")")), true
+	}
+
+	return "", "", false
+}
+
+// Kind returns the expression's kind.
+func (e Expression) Kind() Kind {
+	return e.kind
+}
+
+// String returns the expression's canonical form.
+func (e Expression) String() string {
+	switch e.kind {
+	case KindAt:
+		return fmt.Sprintf("at(%s)", e.at.Format(AtLayout))
+	case KindCron:
+		return fmt.Sprintf("cron(%s)", strings.Join(e.cron.fields[:], " "))
+	case KindRate:
+		return fmt.Sprintf("rate(%s)", formatRate(e.rate))
+	}
+
+	return ""
+}
+
+// Next returns up to n fire times strictly after the specified time.
+// Cron and at() expressions are evaluated in after's location.
+// A rate() expression is anchored at the time its schedule is created,
+// so its fire times are approximated as multiples of the rate from after.
+func (e Expression) Next(after time.Time, n int) []time.Time {
+	var times []time.Time
+
+	if n <= 0 {
+		return times
+	}
+
+	switch e.kind {
+	case KindAt:
+		at := time.Date(e.at.Year(), e.at.Month(), e.at.Day(), e.at.Hour(), e.at.Minute(), e.at.Second(), 0, after.Location())
+		if at.After(after) {
+			times = append(times, at)
+		}
+
+	case KindCron:
+		times = e.cron.next(after, n)
+
+	case KindRate:
+		for i := 1; i <= n; i++ {
+			times = append(times, after.Add(time.Duration(i)*e.rate))
+		}
+	}
+
+	return times
+}
+
+func parseRate(s string) (time.Duration, error) {
+	parts := strings.Fields(s)
+	if len(parts) != 2 {
+		return 0, errors.New("must be of the form rate(value unit)")
+	}
+
+	value, err := strconv.Atoi(parts[0])
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("value %q must be a positive integer", parts[0])
+	}
+
+	var unit time.Duration
+	singular, plural := "", ""
+	switch parts[1] {
+	case "minute", "minutes":
+		unit, singular, plural = time.Minute, "minute", "minutes"
+	case "hour", "hours":
+		unit, singular, plural = time.Hour, "hour", "hours"
+	case "day", "days":
+		unit, singular, plural = 24*time.Hour, "day", "days"
+	default:
+		return 0, fmt.Errorf("unit %q must be one of minute(s), hour(s) or day(s)", parts[1])
+	}
+
+	if value == 1 && parts[1] != singular {
+		return 0, fmt.Errorf("unit must be %q for a value of 1", singular)
+	}
+	if value > 1 && parts[1] != plural {
+		return 0, fmt.Errorf("unit must be %q for a value greater than 1", plural)
+	}
+
+	return time.Duration(value) * unit, nil
+}
+
+func formatRate(d time.Duration) string {
+	for _, u := range []struct {
+		unit             time.Duration
+		singular, plural string
+	}{
+		{24 * time.Hour, "day", "days"},
+		{time.Hour, "hour", "hours"},
+		{time.Minute, "minute", "minutes"},
+	} {
+		if d%u.unit != 0 {
+			continue
+		}
+		if v := d / u.unit; v != 1 {
+			return fmt.Sprintf("%d %s", v, u.plural)
+		}
+		return "1 " + u.singular
+	}
+
+	return d.String()
+}
+
+const (
+	fieldMinutes = iota
+	fieldHours
+	fieldDayOfMonth
+	fieldMonth
+	fieldDayOfWeek
+	fieldYear
+	fieldCount
+)
+
+var fieldNames = [fieldCount]string{
+	"minutes",
+	"hours",
+	"day-of-month",
+	"month",
+	"day-of-week",
+	"year",
+}
+
+var (
+	monthNames   = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+	weekdayNames = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+)
+
+// cron is a parsed six-field AWS cron expression.
+type cron struct {
+	fields  [fieldCount]string // Canonical field values.
+	minutes []bool             // Indexed by minute.
+	hours   []bool             // Indexed by hour.
+	months  []bool             // Indexed by month (1-12).
+	years   []bool             // Indexed by year - minYear.
+
+	// Day-of-month. Ignored if anyDayOfMonth.
+	anyDayOfMonth      bool
+	daysOfMonth        []bool // Indexed by day (1-31).
+	lastDayOfMonth     bool   // L
+	lastWeekdayOfMonth bool   // LW
+	nearestWeekdays    []int  // nW
+
+	// Day-of-week. Ignored if anyDayOfWeek.
+	anyDayOfWeek   bool
+	daysOfWeek     []bool         // Indexed by time.Weekday.
+	lastDaysOfWeek []time.Weekday // nL
+	nthDaysOfWeek  [][2]int       // n#m: {weekday, m}
+}
+
+func parseCron(s string) (*cron, error) {
+	parts := strings.Fields(s)
+	if len(parts) != fieldCount {
+		return nil, fmt.Errorf("expected %d fields (minutes hours day-of-month month day-of-week year), got %d", fieldCount, len(parts))
+	}
+
+	c := &cron{}
+	for i, part := range parts {
+		c.fields[i] = strings.ToUpper(part)
+	}
+
+	var err error
+	if c.minutes, err = parseField(c.fields[fieldMinutes], 0, 59, nil); err != nil {
+		return nil, fieldError(fieldMinutes, err)
+	}
+	if c.hours, err = parseField(c.fields[fieldHours], 0, 23, nil); err != nil {
+		return nil, fieldError(fieldHours, err)
+	}
+	if c.months, err = parseField(c.fields[fieldMonth], 1, 12, monthNames); err != nil {
+		return nil, fieldError(fieldMonth, err)
+	}
+	if c.years, err = parseField(c.fields[fieldYear], minYear, maxYear, nil); err != nil {
+		return nil, fieldError(fieldYear, err)
+	}
+
+	c.anyDayOfMonth, c.anyDayOfWeek = c.fields[fieldDayOfMonth] == "?", c.fields[fieldDayOfWeek] == "?"
+	switch {
+	case c.anyDayOfMonth && c.anyDayOfWeek:
+		return nil, errors.New("only one of day-of-month and day-of-week can be ?")
+	case !c.anyDayOfMonth && !c.anyDayOfWeek:
+		return nil, errors.New("one of day-of-month and day-of-week must be ?")
+	}
+
+	if !c.anyDayOfMonth {
+		if err := c.parseDayOfMonth(c.fields[fieldDayOfMonth]); err != nil {
+			return nil, fieldError(fieldDayOfMonth, err)
+		}
+	}
+	if !c.anyDayOfWeek {
+		if err := c.parseDayOfWeek(c.fields[fieldDayOfWeek]); err != nil {
+			return nil, fieldError(fieldDayOfWeek, err)
+		}
+	}
+
+	return c, nil
+}
+
+func fieldError(field int, err error) error {
+	return fmt.Errorf("%s: %w", fieldNames[field], err)
+}
+
+func (c *cron) parseDayOfMonth(s string) error {
+	var values []string
+
+	for _, v := range strings.Split(s, ",") {
+		switch {
+		case v == "L":
+			c.lastDayOfMonth = true
+		case v == "LW":
+			c.lastWeekdayOfMonth = true
+		case strings.HasSuffix(v, "W"):
+			day, err := parseValue(strings.TrimSuffix(v, "W"), 1, 31, nil)
+			if err != nil {
+				return err
+			}
+			c.nearestWeekdays = append(c.nearestWeekdays, day)
+		default:
+			values = append(values, v)
+		}
+	}
+
+	if len(values) > 0 {
+		days, err := parseField(strings.Join(values, ","), 1, 31, nil)
+		if err != nil {
+			return err
+		}
+		c.daysOfMonth = days
+	}
+
+	return nil
+}
+
+func (c *cron) parseDayOfWeek(s string) error {
+	var values []string
+
+	for _, v := range strings.Split(s, ",") {
+		switch {
+		case v == "L":
+			// L on its own is the last day of the week (Saturday).
+			values = append(values, "7")
+		case strings.HasSuffix(v, "L"):
+			day, err := parseValue(strings.TrimSuffix(v, "L"), 1, 7, weekdayNames)
+			if err != nil {
+				return err
+			}
+			c.lastDaysOfWeek = append(c.lastDaysOfWeek, time.Weekday(day-1))
+		case strings.Contains(v, "#"):
+			day, nth, _ := strings.Cut(v, "#")
+			d, err := parseValue(day, 1, 7, weekdayNames)
+			if err != nil {
+				return err
+			}
+			n, err := parseValue(nth, 1, 5, nil)
+			if err != nil {
+				return fmt.Errorf("occurrence in %q: %w", v, err)
+			}
+			c.nthDaysOfWeek = append(c.nthDaysOfWeek, [2]int{d - 1, n})
+		default:
+			values = append(values, v)
+		}
+	}
+
+	if len(values) > 0 {
+		days, err := parseField(strings.Join(values, ","), 1, 7, weekdayNames)
+		if err != nil {
+			return err
+		}
+		// Convert from 1 (SUN) - 7 (SAT) to time.Weekday.
+		c.daysOfWeek = days[1:]
+	}
+
+	return nil
+}
+
+// parseField parses a comma-separated list of `*`, `n`, `n-m`, `*/s`, `n/s` and `n-m/s` terms
+// into a slice indexed by value (offset by min for fields that don't start at 0 or 1).
+func parseField(s string, min, max int, names []string) ([]bool, error) {
+	offset := 0
+	if min > 1 {
+		offset = min
+	}
+	set := make([]bool, max-offset+1)
+
+	for _, term := range strings.Split(s, ",") {
+		rng, step, hasStep := strings.Cut(term, "/")
+
+		var lo, hi int
+		switch {
+		case rng == "*":
+			lo, hi = min, max
+		case strings.Contains(rng, "-"):
+			from, to, _ := strings.Cut(rng, "-")
+			var err error
+			if lo, err = parseValue(from, min, max, names); err != nil {
+				return nil, err
+			}
+			if hi, err = parseValue(to, min, max, names); err != nil {
+				return nil, err
+			}
+			if lo > hi {
+				return nil, fmt.Errorf("range %q is reversed", rng)
+			}
+		default:
+			var err error
+			if lo, err = parseValue(rng, min, max, names); err != nil {
+				return nil, err
+			}
+			hi = lo
+			if hasStep {
+				hi = max
+			}
+		}
+
+		inc := 1
+		if hasStep {
+			v, err := strconv.Atoi(step)
+			if err != nil || v <= 0 {
+				return nil, fmt.Errorf("increment %q must be a positive integer", step)
+			}
+			inc = v
+		}
+
+		for v := lo; v <= hi; v += inc {
+			set[v-offset] = true
+		}
+	}
+
+	return set, nil
+}
+
+func parseValue(s string, min, max int, names []string) (int, error) {
+	if i := slices.Index(names, s); i >= 0 {
+		return i + 1, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+
+	if v < min || v > max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, min, max)
+	}
+
+	return v, nil
+}
+
+func (c *cron) next(after time.Time, n int) []time.Time {
+	var times []time.Time
+
+	loc := after.Location()
+	day := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, loc)
+
+	for ; day.Year() <= maxYear && len(times) < n; day = day.AddDate(0, 0, 1) {
+		if !c.matchesDay(day) {
+			continue
+		}
+
+		for hour, ok := range c.hours {
+			if !ok {
+				continue
+			}
+			for minute, ok := range c.minutes {
+				if !ok {
+					continue
+				}
+
+				t := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, loc)
+				if !t.After(after) {
+					continue
+				}
+
+				times = append(times, t)
+				if len(times) == n {
+					return times
+				}
+			}
+		}
+	}
+
+	return times
+}
+
+func (c *cron) matchesDay(day time.Time) bool {
+	if year := day.Year(); year < minYear || year > maxYear || !c.years[year-minYear] {
+		return false
+	}
+
+	if !c.months[day.Month()] {
+		return false
+	}
+
+	if !c.anyDayOfMonth {
+		return c.matchesDayOfMonth(day)
+	}
+
+	return c.matchesDayOfWeek(day)
+}
+
+func (c *cron) matchesDayOfMonth(day time.Time) bool {
+	d := day.Day()
+
+	if c.daysOfMonth != nil && c.daysOfMonth[d] {
+		return true
+	}
+
+	last := daysIn(day)
+
+	if c.lastDayOfMonth && d == last {
+		return true
+	}
+
+	if c.lastWeekdayOfMonth && d == nearestWeekday(day, last) {
+		return true
+	}
+
+	for _, target := range c.nearestWeekdays {
+		if target <= last && d == nearestWeekday(day, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *cron) matchesDayOfWeek(day time.Time) bool {
+	weekday := day.Weekday()
+
+	if c.daysOfWeek != nil && c.daysOfWeek[weekday] {
+		return true
+	}
+
+	d := day.Day()
+
+	for _, target := range c.lastDaysOfWeek {
+		if weekday == target && d+7 > daysIn(day) {
+			return true
+		}
+	}
+
+	for _, v := range c.nthDaysOfWeek {
+		if int(weekday) == v[0] && (d-1)/7+1 == v[1] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// daysIn returns the number of days in the month of the specified time.
+func daysIn(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// nearestWeekday returns the day of the month of the weekday nearest to the target day,
+// without crossing into another month.
+func nearestWeekday(t time.Time, target int) int {
+	last := daysIn(t)
+
+	switch time.Date(t.Year(), t.Month(), target, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if target == 1 {
+			return target + 2
+		}
+		return target - 1
+	case time.Sunday:
+		if target == last {
+			return target - 2
+		}
+		return target + 1
+	}
+
+	return target
+}
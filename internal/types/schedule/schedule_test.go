@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		input        string
+		expectedKind Kind
+		expectedErr  string
+	}{
+		// Invalid
+		"empty": {
+			input:       "",
+			expectedErr: "must be of the form",
+		},
+		"unknown kind": {
+			input:       "every(5 minutes)",
+			expectedErr: "must be of the form",
+		},
+		"no closing parenthesis": {
+			input:       "rate(5 minutes",
+			expectedErr: "must be of the form",
+		},
+
+		// rate()
+		"rate minutes": {
+			input:        "rate(5 minutes)",
+			expectedKind: KindRate,
+		},
+		"rate 1 hour": {
+			input:        "rate(1 hour)",
+			expectedKind: KindRate,
+		},
+		"rate 1 hours": {
+			input:       "rate(1 hours)",
+			expectedErr: `unit must be "hour" for a value of 1`,
+		},
+		"rate 2 day": {
+			input:       "rate(2 day)",
+			expectedErr: `unit must be "days" for a value greater than 1`,
+		},
+		"rate zero": {
+			input:       "rate(0 minutes)",
+			expectedErr: `value "0" must be a positive integer`,
+		},
+		"rate invalid unit": {
+			input:       "rate(5 seconds)",
+			expectedErr: `unit "seconds" must be one of minute(s), hour(s) or day(s)`,
+		},
+
+		// at()
+		"at": {
+			input:        "at(2025-10-01T12:30:00)",
+			expectedKind: KindAt,
+		},
+		"at with zone": {
+			input:       "at(2025-10-01T12:30:00Z)",
+			expectedErr: "timestamp must be of the form yyyy-mm-ddThh:mm:ss",
+		},
+
+		// cron()
+		"cron every 5 minutes": {
+			input:        "cron(0/5 * * * ? *)",
+			expectedKind: KindCron,
+		},
+		"cron weekdays": {
+			input:        "cron(0 18 ? * MON-FRI *)",
+			expectedKind: KindCron,
+		},
+		"cron last day of month": {
+			input:        "cron(0 12 L * ? *)",
+			expectedKind: KindCron,
+		},
+		"cron nearest weekday": {
+			input:        "cron(0 12 15W * ? *)",
+			expectedKind: KindCron,
+		},
+		"cron nth day of week": {
+			input:        "cron(0 12 ? * 3#2 2025-2030)",
+			expectedKind: KindCron,
+		},
+		"cron last Friday": {
+			input:        "cron(0 12 ? JAN,JUL 6L *)",
+			expectedKind: KindCron,
+		},
+		"cron five fields": {
+			input:       "cron(0 12 * * ?)",
+			expectedErr: "expected 6 fields",
+		},
+		"cron both day fields": {
+			input:       "cron(0 12 1 * MON *)",
+			expectedErr: "one of day-of-month and day-of-week must be ?",
+		},
+		"cron neither day field": {
+			input:       "cron(0 12 ? * ? *)",
+			expectedErr: "only one of day-of-month and day-of-week can be ?",
+		},
+		"cron minutes out of range": {
+			input:       "cron(60 12 * * ? *)",
+			expectedErr: "minutes: value 60 out of range [0, 59]",
+		},
+		"cron invalid month name": {
+			input:       "cron(0 12 * FOO ? *)",
+			expectedErr: `month: invalid value "FOO"`,
+		},
+		"cron reversed range": {
+			input:       "cron(0 18-6 * * ? *)",
+			expectedErr: `hours: range "18-6" is reversed`,
+		},
+		"cron year out of range": {
+			input:       "cron(0 12 * * ? 2200)",
+			expectedErr: "year: value 2200 out of range [1970, 2199]",
+		},
+		"cron invalid increment": {
+			input:       "cron(0/0 12 * * ? *)",
+			expectedErr: `minutes: increment "0" must be a positive integer`,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expression, err := Parse(testcase.input)
+
+			if testcase.expectedErr != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got none", testcase.expectedErr)
+				}
+				if !errors.Is(err, ErrSyntax) {
+					t.Errorf("expected error to wrap ErrSyntax, got %q", err)
+				}
+				if !strings.Contains(err.Error(), testcase.expectedErr) {
+					t.Errorf("expected error containing %q, got %q", testcase.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := expression.Kind(), testcase.expectedKind; got != want {
+				t.Errorf("Kind() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestExpressionString(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		input    string
+		expected string
+	}{
+		"rate": {
+			input:    "rate( 5  minutes )",
+			expected: "rate(5 minutes)",
+		},
+		"cron": {
+			input:    "cron(0  12 ? jan,jul mon-fri *)",
+			expected: "cron(0 12 ? JAN,JUL MON-FRI *)",
+		},
+		"at": {
+			input:    "at(2025-10-01T12:30:00)",
+			expected: "at(2025-10-01T12:30:00)",
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expression, err := Parse(testcase.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := expression.String(), testcase.expected; got != want {
+				t.Errorf("String() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestExpressionNext(t *testing.T) {
+	t.Parallel()
+
+	// Wednesday.
+	after := time.Date(2025, time.January, 1, 10, 0, 0, 0, time.UTC)
+
+	testcases := map[string]struct {
+		input    string
+		n        int
+		expected []string
+	}{
+		"rate": {
+			input:    "rate(2 hours)",
+			n:        2,
+			expected: []string{"2025-01-01T12:00:00Z", "2025-01-01T14:00:00Z"},
+		},
+		"at in future": {
+			input:    "at(2025-03-01T08:00:00)",
+			n:        3,
+			expected: []string{"2025-03-01T08:00:00Z"},
+		},
+		"at in past": {
+			input: "at(2024-03-01T08:00:00)",
+			n:     3,
+		},
+		"cron every 15 minutes": {
+			input:    "cron(0/15 * * * ? *)",
+			n:        3,
+			expected: []string{"2025-01-01T10:15:00Z", "2025-01-01T10:30:00Z", "2025-01-01T10:45:00Z"},
+		},
+		"cron weekdays": {
+			input:    "cron(0 9 ? * MON-FRI *)",
+			n:        3,
+			expected: []string{"2025-01-02T09:00:00Z", "2025-01-03T09:00:00Z", "2025-01-06T09:00:00Z"},
+		},
+		"cron last day of month": {
+			input:    "cron(0 0 L * ? *)",
+			n:        2,
+			expected: []string{"2025-01-31T00:00:00Z", "2025-02-28T00:00:00Z"},
+		},
+		"cron nearest weekday": {
+			// 2025-02-01 is a Saturday, 2025-03-01 is a Saturday.
+			input:    "cron(0 0 1W * ? *)",
+			n:        2,
+			expected: []string{"2025-02-03T00:00:00Z", "2025-03-03T00:00:00Z"},
+		},
+		"cron second Tuesday": {
+			input:    "cron(30 6 ? * 3#2 *)",
+			n:        2,
+			expected: []string{"2025-01-14T06:30:00Z", "2025-02-11T06:30:00Z"},
+		},
+		"cron last Friday": {
+			input:    "cron(0 17 ? * FRIL *)",
+			n:        2,
+			expected: []string{"2025-01-31T17:00:00Z", "2025-02-28T17:00:00Z"},
+		},
+		"cron year in past": {
+			input: "cron(0 0 1 1 ? 2020)",
+			n:     1,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expression, err := Parse(testcase.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+			for _, t := range expression.Next(after, testcase.n) {
+				got = append(got, t.Format(time.RFC3339))
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
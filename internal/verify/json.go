@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
 // SuppressEquivalentPolicyDiffs returns a difference suppression function that compares
@@ -66,6 +67,24 @@ func SuppressEquivalentJSONWithEmptyDiffs(k, old, new string, d *schema.Resource
 	return JSONStringsEqual(old, new)
 }
 
+// SuppressEquivalentEventPatternDiffs returns a difference suppression function that compares
+// two JSON strings representing Amazon EventBridge event patterns and returns `true` if they are semantically equivalent.
+func SuppressEquivalentEventPatternDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return tfjson.EqualStringsNormalized(old, new, tfjson.NormalizeEventPattern)
+}
+
+// SuppressEquivalentStateMachineDefinitionDiffs returns a difference suppression function that compares
+// two JSON strings representing AWS Step Functions state machine definitions and returns `true` if they are semantically equivalent.
+func SuppressEquivalentStateMachineDefinitionDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return tfjson.EqualStringsNormalized(old, new, tfjson.NormalizeStateMachineDefinition)
+}
+
+// SuppressEquivalentDashboardBodyDiffs returns a difference suppression function that compares
+// two JSON strings representing Amazon CloudWatch dashboard bodies and returns `true` if they are semantically equivalent.
+func SuppressEquivalentDashboardBodyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return tfjson.EqualStringsNormalized(old, new, tfjson.NormalizeDashboardBody)
+}
+
 func SuppressEquivalentJSONOrYAMLDiffs(k, old, new string, d *schema.ResourceData) bool {
 	normalizedOld, err := NormalizeJSONOrYAMLString(old)
 
@@ -474,6 +474,27 @@ func TestSuppressEquivalentJSONDiffsWhitespaceAndNoWhitespace(t *testing.T) {
 	}
 }
 
+func TestSuppressEquivalentEventPatternDiffs(t *testing.T) {
+	t.Parallel()
+
+	d := new(schema.ResourceData)
+
+	old := `{"source":["aws.ec2","aws.s3"]}`
+	new := `{
+  "source": ["aws.s3", "aws.ec2"]
+}`
+
+	if !SuppressEquivalentEventPatternDiffs("", old, new, d) {
+		t.Errorf("Expected SuppressEquivalentEventPatternDiffs to return true for %s == %s", old, new)
+	}
+
+	newDiff := `{"source":["aws.s3"]}`
+
+	if SuppressEquivalentEventPatternDiffs("", old, newDiff, d) {
+		t.Errorf("Expected SuppressEquivalentEventPatternDiffs to return false for %s == %s", old, newDiff)
+	}
+}
+
 func TestSuppressEquivalentJSONOrYAMLDiffs(t *testing.T) {
 	t.Parallel()
 
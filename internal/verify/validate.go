@@ -22,8 +22,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/timestamp"
 )
 
@@ -142,6 +144,27 @@ func ValidARNCheck(f ...ARNCheckFunc) schema.SchemaValidateFunc {
 	}
 }
 
+// ValidARNOfType validates that a string value is an ARN of one of the specified types
+// whose Region, if any, is in its partition.
+func ValidARNOfType(arnTypes ...itypes.ARNType) schema.SchemaValidateFunc {
+	return ValidARNCheck(func(v any, k string, a arn.ARN) (ws []string, errors []error) {
+		if err := itypes.ValidateARNOfType(a, arnTypes...); err != nil {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, v, err))
+		}
+
+		return ws, errors
+	})
+}
+
+// ValidIAMRoleARN validates that a string value is an IAM role ARN.
+var ValidIAMRoleARN = ValidARNOfType(itypes.ARNTypeIAMRole)
+
+// ValidKMSKeyARN validates that a string value is a KMS key or alias ARN.
+var ValidKMSKeyARN = ValidARNOfType(itypes.ARNTypeKMSKey, itypes.ARNTypeKMSAlias)
+
+// ValidS3BucketARN validates that a string value is an S3 bucket ARN.
+var ValidS3BucketARN = ValidARNOfType(itypes.ARNTypeS3Bucket)
+
 func ValidAccountID(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -177,6 +200,17 @@ func ValidCIDRNetworkAddress(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
+// ValidGlob validates that a string is a valid glob pattern.
+func ValidGlob(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if err := tfio.ValidateGlob(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid glob pattern (%q): %w", k, value, err))
+	}
+
+	return
+}
+
 func ValidIAMPolicyJSON(v interface{}, k string) (ws []string, errors []error) {
 	// IAM Policy documents need to be valid JSON, and pass legacy parsing
 	value := v.(string)
@@ -434,6 +468,39 @@ func ValidTypeStringNullableFloat(v interface{}, k string) (ws []string, es []er
 	return
 }
 
+// ValidScheduleExpression returns a SchemaValidateFunc which tests if the provided value
+// is a valid schedule expression of one of the specified kinds.
+// If no kinds are specified, cron() and rate() expressions are allowed.
+func ValidScheduleExpression(kinds ...schedule.Kind) schema.SchemaValidateFunc {
+	if len(kinds) == 0 {
+		kinds = []schedule.Kind{schedule.KindCron, schedule.KindRate}
+	}
+
+	return func(v any, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return ws, errors
+		}
+
+		if value == "" {
+			return ws, errors
+		}
+
+		expression, err := schedule.Parse(value)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid schedule expression: %s", k, value, err))
+			return ws, errors
+		}
+
+		if !slices.Contains(kinds, expression.Kind()) {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid schedule expression: %s() schedule expressions are not supported", k, value, expression.Kind()))
+		}
+
+		return ws, errors
+	}
+}
+
 // ValidUTCTimestamp validates a string in UTC Format required by APIs including:
 // https://docs.aws.amazon.com/iot/latest/apireference/API_CloudwatchMetricAction.html
 // https://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_RestoreDBInstanceToPointInTime.html
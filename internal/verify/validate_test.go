@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 )
 
 func TestValidAmazonSideASN(t *testing.T) {
@@ -225,6 +226,98 @@ func TestValidARN(t *testing.T) {
 	}
 }
 
+func TestValidARNOfType(t *testing.T) {
+	t.Parallel()
+
+	v := ""
+	_, errors := ValidIAMRoleARN(v, "role_arn")
+	if len(errors) != 0 {
+		t.Fatalf("%q should not be validated as an ARN: %q", v, errors)
+	}
+
+	validRoleARNs := []string{
+		"arn:aws:iam::123456789012:role/example",                  // lintignore:AWSAT005
+		"arn:aws:iam::123456789012:role/service-role/example",     // lintignore:AWSAT005
+		"arn:aws-us-gov:iam::123456789012:role/example",           // lintignore:AWSAT005
+		"arn:aws:iam::123456789012:role/aws-service-role/example", // lintignore:AWSAT005
+	}
+	for _, v := range validRoleARNs {
+		_, errors := ValidIAMRoleARN(v, "role_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IAM role ARN: %q", v, errors)
+		}
+	}
+
+	invalidRoleARNs := []string{
+		"example",
+		"arn:aws:iam::123456789012:user/example",                   // lintignore:AWSAT005
+		"arn:aws:iam::123456789012:policy/example",                 // lintignore:AWSAT005
+		"arn:aws:sts::123456789012:assumed-role/example/session",   // lintignore:AWSAT005
+		"arn:aws:kms:us-west-2:123456789012:key/example",           // lintignore:AWSAT003,AWSAT005
+		"arn:aws:s3:::example",                                     // lintignore:AWSAT005
+		"arn:aws:lambda:us-west-2:123456789012:function:role/role", // lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidRoleARNs {
+		_, errors := ValidIAMRoleARN(v, "role_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IAM role ARN", v)
+		}
+	}
+
+	validKeyARNs := []string{
+		"arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",         // lintignore:AWSAT003,AWSAT005
+		"arn:aws:kms:us-west-2:123456789012:key/mrk-1234abcd12ab34cd56ef1234567890ab",         // lintignore:AWSAT003,AWSAT005
+		"arn:aws:kms:us-west-2:123456789012:alias/example",                                    // lintignore:AWSAT003,AWSAT005
+		"arn:aws-cn:kms:cn-north-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",     // lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:kms:us-gov-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-123456789", // lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validKeyARNs {
+		_, errors := ValidKMSKeyARN(v, "kms_key_id")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid KMS key ARN: %q", v, errors)
+		}
+	}
+
+	invalidKeyARNs := []string{
+		"1234abcd-12ab-34cd-56ef-1234567890ab",
+		"arn:aws:iam::123456789012:role/example",                                           // lintignore:AWSAT005
+		"arn:aws:kms:us-west-2:123456789012:grant/example",                                 // lintignore:AWSAT003,AWSAT005
+		"arn:aws:kms:cn-north-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",     // lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890", // lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidKeyARNs {
+		_, errors := ValidKMSKeyARN(v, "kms_key_id")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid KMS key ARN", v)
+		}
+	}
+
+	validBucketARNs := []string{
+		"arn:aws:s3:::example",        // lintignore:AWSAT005
+		"arn:aws-cn:s3:::example",     // lintignore:AWSAT005
+		"arn:aws-us-gov:s3:::example", // lintignore:AWSAT005
+	}
+	for _, v := range validBucketARNs {
+		_, errors := ValidS3BucketARN(v, "bucket_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid S3 bucket ARN: %q", v, errors)
+		}
+	}
+
+	invalidBucketARNs := []string{
+		"example",
+		"arn:aws:iam::123456789012:role/example", // lintignore:AWSAT005
+		"arn:aws:s3:us-west-2:123456789012:accesspoint/example",         // lintignore:AWSAT003,AWSAT005
+		"arn:aws:s3-object-lambda:us-west-2:123456789012:accesspoint/x", // lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidBucketARNs {
+		_, errors := ValidS3BucketARN(v, "bucket_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid S3 bucket ARN", v)
+		}
+	}
+}
+
 func TestValidCIDRNetworkAddress(t *testing.T) {
 	t.Parallel()
 
@@ -646,6 +739,43 @@ func TestValidLaunchTemplateID(t *testing.T) {
 	}
 }
 
+func TestValidScheduleExpression(t *testing.T) {
+	t.Parallel()
+
+	validT := []string{
+		"cron(0 12 ? * MON-FRI *)",
+		"cron(0/15 * * * ? *)",
+		"rate(1 day)",
+		"rate(30 minutes)",
+	}
+
+	invalidT := []string{
+		"cron(0 12 * * ?)",
+		"cron(0 12 1 * MON *)",
+		"rate(1 days)",
+		"at(2025-10-01T12:30:00)",
+		"daily",
+	}
+
+	for _, f := range validT {
+		_, errors := ValidScheduleExpression()(f, "schedule_expression")
+		if len(errors) > 0 {
+			t.Fatalf("expected the expression %q to be valid, got error %q", f, errors)
+		}
+	}
+
+	for _, f := range invalidT {
+		_, errors := ValidScheduleExpression()(f, "schedule_expression")
+		if len(errors) == 0 {
+			t.Fatalf("expected the expression %q to fail validation", f)
+		}
+	}
+
+	if _, errors := ValidScheduleExpression(schedule.KindAt)("at(2025-10-01T12:30:00)", "schedule_expression"); len(errors) > 0 {
+		t.Fatalf("expected the at() expression to be valid, got error %q", errors)
+	}
+}
+
 func TestValidUTCTimestamp(t *testing.T) {
 	t.Parallel()
 
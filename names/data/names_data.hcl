@@ -7761,7 +7761,6 @@ service "sso" {
   endpoint_info {
     endpoint_api_call   = "ListAccounts"
     endpoint_api_params = "AccessToken: aws.String(\"mock-access-token\")"
-    endpoint_only       = true
   }
 
   resource_prefix {
@@ -7771,7 +7770,6 @@ service "sso" {
   provider_package_correct = "sso"
   doc_prefix               = ["sso_"]
   brand                    = "AWS"
-  not_implemented          = true
 }
 
 service "ssoadmin" {
@@ -160,6 +160,14 @@ func PartitionForRegion(region string) endpoints.Partition {
 	return PartitionForRegion(endpoints.UsEast1RegionID)
 }
 
+// IsRegionInPartition returns whether the given Region is in the given partition.
+// Returns true if no known partition includes the Region.
+func IsRegionInPartition(region, partitionID string) bool {
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+
+	return !ok || partition.ID() == partitionID
+}
+
 // Type ServiceDatum corresponds closely to attributes and blocks in `data/names_data.hcl` and are
 // described in detail in README.md.
 type serviceDatum struct {
@@ -59,6 +59,52 @@ func TestPartitionForRegion(t *testing.T) {
 	}
 }
 
+func TestIsRegionInPartition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		region    string
+		partition string
+		expected  bool
+	}{
+		{
+			name:      "China",
+			region:    endpoints.CnNorth1RegionID,
+			partition: endpoints.AwsCnPartitionID,
+			expected:  true,
+		},
+		{
+			name:      "China in standard partition",
+			region:    endpoints.CnNorth1RegionID,
+			partition: endpoints.AwsPartitionID,
+			expected:  false,
+		},
+		{
+			name:      "standard in GovCloud partition",
+			region:    endpoints.UsWest2RegionID,
+			partition: endpoints.AwsUsGovPartitionID,
+			expected:  false,
+		},
+		{
+			name:      "unknown",
+			region:    "custom",
+			partition: endpoints.AwsUsGovPartitionID,
+			expected:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := IsRegionInPartition(testCase.region, testCase.partition), testCase.expected; got != want {
+				t.Errorf("got: %t, expected: %t", got, want)
+			}
+		})
+	}
+}
+
 func TestProviderPackageForAlias(t *testing.T) {
 	t.Parallel()
 